@@ -0,0 +1,5 @@
+# Import an existing database by name
+terraform import starrocks_database.example analytics
+
+# Import a database from a specific catalog
+terraform import starrocks_database.example default_catalog.analytics
@@ -0,0 +1,12 @@
+resource "starrocks_database" "example" {
+  name            = "analytics"
+  replication_num = 3
+  data_quota      = 10737418240
+  replica_quota   = 100000
+}
+
+resource "starrocks_database" "lake" {
+  catalog        = "default_catalog"
+  name           = "lake"
+  storage_volume = "s3_volume"
+}
@@ -0,0 +1,3 @@
+# Import an external catalog by name. Secrets cannot be read back and must be
+# set in configuration after import.
+terraform import starrocks_external_catalog.hive_lake hive_lake
@@ -0,0 +1,55 @@
+# Hive catalog with data on S3
+resource "starrocks_external_catalog" "hive_lake" {
+  name    = "hive_lake"
+  comment = "Hive tables on S3"
+  type    = "hive"
+
+  metastore = {
+    type = "hive"
+    uri  = "thrift://metastore.example.com:9083"
+  }
+
+  s3 = {
+    region     = "us-west-2"
+    access_key = var.s3_access_key
+    secret_key = var.s3_secret_key
+  }
+
+  properties = {
+    enable_metastore_cache = "true"
+  }
+}
+
+# Iceberg catalog on AWS Glue using instance profiles
+resource "starrocks_external_catalog" "iceberg_glue" {
+  name = "iceberg_glue"
+  type = "iceberg"
+
+  metastore = {
+    type = "glue"
+  }
+
+  glue = {
+    region               = "us-west-2"
+    use_instance_profile = true
+  }
+
+  s3 = {
+    region               = "us-west-2"
+    use_instance_profile = true
+  }
+}
+
+# MySQL database through JDBC
+resource "starrocks_external_catalog" "mysql_app" {
+  name = "mysql_app"
+  type = "jdbc"
+
+  jdbc = {
+    uri          = "jdbc:mysql://mysql.example.com:3306"
+    user         = "starrocks"
+    password     = var.mysql_password
+    driver_url   = "https://repo1.maven.org/maven2/mysql/mysql-connector-java/8.0.28/mysql-connector-java-8.0.28.jar"
+    driver_class = "com.mysql.cj.jdbc.Driver"
+  }
+}
@@ -0,0 +1,3 @@
+# Import a global variable using its name. Imported variables are reset to
+# the server default on destroy.
+terraform import starrocks_global_variable.query_timeout query_timeout
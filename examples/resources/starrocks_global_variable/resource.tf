@@ -0,0 +1,9 @@
+resource "starrocks_global_variable" "query_timeout" {
+  name  = "query_timeout"
+  value = "600"
+}
+
+resource "starrocks_global_variable" "enable_spill" {
+  name  = "enable_spill"
+  value = "ON"
+}
@@ -0,0 +1,8 @@
+# Import the privileges of a role on all tables of a database
+terraform import starrocks_grant.analyst_tables "role|analyst|TABLE|analytics.*"
+
+# Import the privileges of a user on a single table
+terraform import starrocks_grant.etl_events "user|'etl'@'%'|TABLE|analytics.events"
+
+# Import system privileges, which have no object
+terraform import starrocks_grant.admin "role|admin|SYSTEM"
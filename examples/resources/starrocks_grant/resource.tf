@@ -0,0 +1,32 @@
+# Read access to every table of a database
+resource "starrocks_grant" "analyst_tables" {
+  role        = "analyst"
+  object_type = "TABLE"
+  database    = "analytics"
+  object_name = "*"
+  privileges  = ["SELECT"]
+}
+
+# Privileges on a single table, which the grantee may pass on
+resource "starrocks_grant" "etl_events" {
+  user              = "'etl'@'%'"
+  object_type       = "TABLE"
+  database          = "analytics"
+  object_name       = "events"
+  privileges        = ["SELECT", "INSERT", "DELETE"]
+  with_grant_option = true
+}
+
+resource "starrocks_grant" "analyst_database" {
+  role        = "analyst"
+  object_type = "DATABASE"
+  database    = "analytics"
+  privileges  = ["CREATE VIEW"]
+}
+
+resource "starrocks_grant" "analyst_resource_group" {
+  role        = "analyst"
+  object_type = "RESOURCE GROUP"
+  object_name = "rg_analytics"
+  privileges  = ["ALL"]
+}
@@ -0,0 +1,2 @@
+# Import a materialized view by <database>.<materialized view>
+terraform import starrocks_materialized_view.daily_pv analytics.daily_pv
@@ -0,0 +1,43 @@
+resource "starrocks_materialized_view" "daily_pv" {
+  database = "analytics"
+  name     = "daily_pv"
+  comment  = "Page views per day"
+
+  refresh = {
+    type     = "ASYNC"
+    moment   = "DEFERRED"
+    start    = "2025-01-01 00:00:00"
+    interval = "1 DAY"
+  }
+
+  partition_by = "date_trunc('day', dt)"
+
+  distribution = {
+    type    = "HASH"
+    columns = ["dt"]
+    buckets = 4
+  }
+
+  properties = {
+    partition_refresh_number  = "3"
+    resource_group            = "rg_mv"
+    query_rewrite_consistency = "LOOSE"
+  }
+
+  query = <<-SQL
+    SELECT dt, count(*) AS pv
+    FROM analytics.events
+    GROUP BY dt
+  SQL
+}
+
+resource "starrocks_materialized_view" "city_pv" {
+  database = "analytics"
+  name     = "city_pv"
+
+  refresh = {
+    type = "MANUAL"
+  }
+
+  query = "SELECT city, count(*) AS pv FROM analytics.events GROUP BY city"
+}
@@ -0,0 +1,4 @@
+# Import a pipe using <database>.<name>. The query and credentials cannot be
+# read back; the first apply after import records them without recreating
+# the pipe.
+terraform import starrocks_pipe.orders analytics.orders_pipe
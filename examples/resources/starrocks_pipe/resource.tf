@@ -0,0 +1,28 @@
+resource "starrocks_pipe" "orders" {
+  database = "analytics"
+  name     = "orders_pipe"
+
+  query = <<-SQL
+    INSERT INTO orders
+    SELECT * FROM FILES(
+      "path" = "s3://starrocks-landing/orders/*.parquet",
+      "format" = "parquet",
+      "aws.s3.region" = "us-west-2"
+    )
+  SQL
+
+  # Added to the FILES() parameters and kept out of the plan output
+  credentials = {
+    "aws.s3.access_key" = var.s3_access_key
+    "aws.s3.secret_key" = var.s3_secret_key
+  }
+
+  auto_ingest   = true
+  poll_interval = 60
+  batch_size    = "1GB"
+  batch_files   = 256
+
+  properties = {
+    "task.query_timeout" = "3600"
+  }
+}
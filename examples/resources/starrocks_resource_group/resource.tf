@@ -12,9 +12,14 @@ resource "starrocks_resource_group" "example" {
       user = "username"
     },
     {
-      role = "admin"
-      query_type = "SELECT"
+      role       = "admin"
+      query_type = ["select", "insert"]
       db         = "analytics"
     },
+    {
+      user                = "etl"
+      source_ip           = "192.168.1.0/24"
+      plan_cpu_cost_range = "[1, 1000)"
+    },
   ]
 }
@@ -0,0 +1,2 @@
+# Import an existing role by name
+terraform import starrocks_role.analyst analyst
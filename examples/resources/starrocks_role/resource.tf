@@ -0,0 +1,10 @@
+resource "starrocks_role" "reader" {
+  name    = "reader"
+  comment = "Read-only access to analytics"
+}
+
+resource "starrocks_role" "analyst" {
+  name         = "analyst"
+  comment      = "Analytics team"
+  parent_roles = [starrocks_role.reader.name]
+}
@@ -0,0 +1,2 @@
+# Import the roles granted to a user by its user identity
+terraform import starrocks_role_grant.analyst "'jane'@'%'"
@@ -0,0 +1,5 @@
+resource "starrocks_role_grant" "analyst" {
+  user          = "'jane'@'%'"
+  roles         = ["analyst", "reader"]
+  default_roles = ["analyst"]
+}
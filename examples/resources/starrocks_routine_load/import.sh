@@ -0,0 +1,4 @@
+# Import a routine load job using <database>.<name>. Columns, the WHERE
+# filter, partitions, offsets and Kafka client properties cannot be read back
+# and must be set in configuration after import.
+terraform import starrocks_routine_load.orders analytics.orders_kafka
@@ -0,0 +1,34 @@
+resource "starrocks_routine_load" "orders" {
+  database = "analytics"
+  name     = "orders_kafka"
+  table    = "orders"
+
+  columns = ["order_id", "amount", "ts", "dt = to_date(ts)"]
+  where   = "amount > 0"
+
+  format                    = "json"
+  jsonpaths                 = jsonencode(["$.id", "$.amount", "$.ts", "$.ts"])
+  desired_concurrent_number = 3
+  max_batch_interval        = 10
+
+  properties = {
+    strict_mode = "true"
+  }
+
+  kafka = {
+    broker_list = "kafka-1:9092,kafka-2:9092"
+    topic       = "orders"
+    partitions  = [0, 1, 2]
+    offsets     = ["OFFSET_BEGINNING", "OFFSET_BEGINNING", "OFFSET_BEGINNING"]
+
+    properties = {
+      "security.protocol" = "SASL_SSL"
+      "sasl.mechanism"    = "PLAIN"
+      "sasl.username"     = "starrocks"
+    }
+
+    credentials = {
+      "sasl.password" = var.kafka_password
+    }
+  }
+}
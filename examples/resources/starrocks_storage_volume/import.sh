@@ -0,0 +1,3 @@
+# Import a storage volume by name. Credentials cannot be read back and must be
+# set in configuration after import.
+terraform import starrocks_storage_volume.s3 s3_volume
@@ -0,0 +1,35 @@
+# S3 storage volume used as the default for new databases
+resource "starrocks_storage_volume" "s3" {
+  name       = "s3_volume"
+  type       = "S3"
+  locations  = ["s3://starrocks-data/shared"]
+  comment    = "Shared data on S3"
+  is_default = true
+
+  properties = {
+    "aws.s3.region"   = "us-west-2"
+    "aws.s3.endpoint" = "https://s3.us-west-2.amazonaws.com"
+  }
+
+  credentials = {
+    "aws.s3.use_aws_sdk_default_behavior" = "false"
+    "aws.s3.access_key"                   = var.s3_access_key
+    "aws.s3.secret_key"                   = var.s3_secret_key
+  }
+}
+
+# Azure Blob Storage volume, created disabled
+resource "starrocks_storage_volume" "azure" {
+  name      = "azure_volume"
+  type      = "AZBLOB"
+  locations = ["azblob://starrocks/shared"]
+  enabled   = false
+
+  properties = {
+    "azure.blob.endpoint" = "https://account.blob.core.windows.net"
+  }
+
+  credentials = {
+    "azure.blob.shared_key" = var.azure_shared_key
+  }
+}
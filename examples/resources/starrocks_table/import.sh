@@ -0,0 +1,2 @@
+# Import a table by <database>.<table>
+terraform import starrocks_table.events analytics.events
@@ -0,0 +1,59 @@
+resource "starrocks_table" "events" {
+  database = "analytics"
+  name     = "events"
+  comment  = "Raw click events"
+
+  columns = [
+    { name = "dt", type = "DATE", nullable = false },
+    { name = "user_id", type = "BIGINT", nullable = false },
+    { name = "city", type = "VARCHAR(64)", default = "unknown" },
+    { name = "ts", type = "DATETIME", default = "CURRENT_TIMESTAMP", comment = "Ingest time" },
+  ]
+
+  key_type    = "DUPLICATE"
+  key_columns = ["dt", "user_id"]
+
+  partitioning = {
+    type    = "RANGE"
+    columns = ["dt"]
+    partitions = [
+      { name = "p2025", values = ["2026-01-01"] },
+      { name = "p2026", values = ["2027-01-01"] },
+    ]
+  }
+
+  distribution = {
+    type    = "HASH"
+    columns = ["user_id"]
+    buckets = 8
+  }
+
+  properties = {
+    replication_num = "1"
+  }
+}
+
+# Aggregate table partitioned automatically by day
+resource "starrocks_table" "daily_pv" {
+  database = "analytics"
+  name     = "daily_pv"
+
+  columns = [
+    { name = "dt", type = "DATETIME", nullable = false },
+    { name = "city", type = "VARCHAR(64)", nullable = false },
+    { name = "pv", type = "BIGINT", aggregation = "SUM" },
+    { name = "last_seen", type = "DATETIME", aggregation = "REPLACE" },
+  ]
+
+  key_type    = "AGGREGATE"
+  key_columns = ["dt", "city"]
+
+  partitioning = {
+    type       = "EXPRESSION"
+    expression = "date_trunc('day', dt)"
+  }
+
+  distribution = {
+    type = "RANDOM"
+  }
+}
@@ -0,0 +1,3 @@
+# Import a task using <database>.<name>. The schedule start time cannot be
+# read back and is left unset.
+terraform import starrocks_task.daily_rollup analytics.daily_rollup
@@ -0,0 +1,18 @@
+resource "starrocks_task" "daily_rollup" {
+  database = "analytics"
+  name     = "daily_rollup"
+
+  query = <<-SQL
+    INSERT OVERWRITE daily_orders
+    SELECT order_date, count(*) FROM orders GROUP BY order_date
+  SQL
+
+  schedule = {
+    start    = "2024-01-01 02:00:00"
+    interval = "1 DAY"
+  }
+
+  properties = {
+    "query_timeout" = "3600"
+  }
+}
@@ -0,0 +1,2 @@
+# Import an existing user by its user identity
+terraform import starrocks_user.analyst "'analyst'@'10.0.%'"
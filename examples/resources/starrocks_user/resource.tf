@@ -0,0 +1,17 @@
+resource "starrocks_user" "analyst" {
+  name          = "analyst"
+  host          = "10.0.%"
+  password      = var.analyst_password
+  default_roles = ["reader"]
+}
+
+resource "starrocks_user" "etl" {
+  name          = "etl"
+  password_hash = "*6BB4837EB74329105EE4568DDA7DC67ED2CA2AD9"
+}
+
+resource "starrocks_user" "ldap" {
+  name        = "tom"
+  auth_plugin = "authentication_ldap_simple"
+  ldap_dn     = "uid=tom,ou=company,dc=example,dc=com"
+}
@@ -0,0 +1,2 @@
+# Import a view by <database>.<view>
+terraform import starrocks_view.daily_pv analytics.daily_pv
@@ -0,0 +1,16 @@
+resource "starrocks_view" "daily_pv" {
+  database = "analytics"
+  name     = "daily_pv"
+  comment  = "Page views per day"
+
+  columns = [
+    { name = "dt", comment = "Event day" },
+    { name = "pv" },
+  ]
+
+  query = <<-SQL
+    SELECT dt, count(*)
+    FROM analytics.events
+    GROUP BY dt
+  SQL
+}
@@ -0,0 +1,2 @@
+# Import a warehouse by name.
+terraform import starrocks_warehouse.etl etl
@@ -0,0 +1,23 @@
+resource "starrocks_warehouse" "etl" {
+  name            = "etl"
+  comment         = "Batch ingestion"
+  compute_replica = 2
+
+  properties = {
+    replication_type = "SYNC"
+  }
+}
+
+# Route ETL queries to the warehouse
+resource "starrocks_resource_group" "etl" {
+  name       = "rg_etl"
+  cpu_weight = 8
+  mem_limit  = "50%"
+  warehouses = [starrocks_warehouse.etl.name]
+
+  classifiers = [
+    {
+      user = "etl"
+    },
+  ]
+}
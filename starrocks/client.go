@@ -1,41 +1,64 @@
 package starrocks
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type Client struct {
-	db *sql.DB
+	db      *sql.DB
+	version Version
 }
 
 type ResourceGroup struct {
-	Name                     types.String
-	CPUWeight                types.Int64
-	ExclusiveCPUCores        types.Int64
-	CPUCoreLimit             types.Int64
-	MaxCPUCores              types.Int64
-	MemLimit                 types.String
-	ConcurrencyLimit         types.Int64
-	BigQueryMemLimit         types.Int64
-	BigQueryScanRowsLimit    types.Int64
-	BigQueryCPUSecondLimit   types.Int64
-	Classifiers              types.List
+	ID                     types.Int64
+	Name                   types.String
+	CPUWeight              types.Int64
+	ExclusiveCPUCores      types.Int64
+	CPUCoreLimit           types.Int64
+	MaxCPUCores            types.Int64
+	MemLimit               PercentageValue
+	ConcurrencyLimit       types.Int64
+	BigQueryMemLimit       types.Int64
+	BigQueryScanRowsLimit  types.Int64
+	BigQueryCPUSecondLimit types.Int64
+	SpillMemLimitThreshold PercentageValue
+	Warehouses             types.Set
+	Classifiers            types.List
+
+	// classifiers holds the parsed classifier rows, including the
+	// server-assigned IDs needed to drop them.
+	classifiers []Classifier
 }
 
 type Classifier struct {
-	ID        int64
-	User      types.String
-	Role      types.String
-	QueryType types.String
-	SourceIP  types.String
-	DB        types.String
+	ID               int64
+	User             types.String
+	Role             types.String
+	QueryType        types.Set
+	SourceIP         types.String
+	DB               types.String
+	PlanCPUCostRange types.String
+	PlanMemCostRange types.String
+}
+
+// classifierAttrTypes describes the object type of a single element of the
+// classifiers attribute.
+var classifierAttrTypes = map[string]attr.Type{
+	"user":                types.StringType,
+	"role":                types.StringType,
+	"query_type":          types.SetType{ElemType: types.StringType},
+	"source_ip":           types.StringType,
+	"db":                  types.StringType,
+	"plan_cpu_cost_range": types.StringType,
+	"plan_mem_cost_range": types.StringType,
 }
 
 type ResourceGroupModel interface {
@@ -44,16 +67,21 @@ type ResourceGroupModel interface {
 	GetExclusiveCPUCores() types.Int64
 	GetCPUCoreLimit() types.Int64
 	GetMaxCPUCores() types.Int64
-	GetMemLimit() types.String
+	GetMemLimit() PercentageValue
 	GetConcurrencyLimit() types.Int64
 	GetBigQueryMemLimit() types.Int64
 	GetBigQueryScanRowsLimit() types.Int64
 	GetBigQueryCPUSecondLimit() types.Int64
+	GetSpillMemLimitThreshold() PercentageValue
+	GetWarehouses() types.Set
 	GetClassifiers() types.List
 }
 
-func NewClient(host, username, password string) (*Client, error) {
-	dsn := fmt.Sprintf("%s:%s@tcp(%s)/", username, password, host)
+func NewClient(cfg ClientConfig) (*Client, error) {
+	dsn, err := buildDSN(cfg)
+	if err != nil {
+		return nil, err
+	}
 	db, err := sql.Open("mysql", dsn)
 	if err != nil {
 		return nil, err
@@ -61,190 +89,691 @@ func NewClient(host, username, password string) (*Client, error) {
 	return &Client{db: db}, nil
 }
 
+// Connect verifies that the FE is reachable with the configured credentials
+// and records the server version.
+func (c *Client) Connect(ctx context.Context) error {
+	if err := c.db.PingContext(ctx); err != nil {
+		return err
+	}
+
+	var raw string
+	if err := c.db.QueryRowContext(ctx, "SELECT current_version()").Scan(&raw); err != nil {
+		return fmt.Errorf("querying server version: %w", err)
+	}
+
+	version, err := ParseVersion(raw)
+	c.version = version
+	return err
+}
+
+// Close closes the connection pool of the client.
+func (c *Client) Close() error {
+	return c.db.Close()
+}
+
+// Version returns the server version recorded by Connect. It is the zero
+// Version if Connect has not succeeded.
+func (c *Client) Version() Version {
+	return c.version
+}
+
+// SupportsVersion reports whether the server is the given release or newer.
+// An unknown version is assumed to support everything.
+func (c *Client) SupportsVersion(major, minor, patch int) bool {
+	return c.version.IsZero() || c.version.AtLeast(major, minor, patch)
+}
+
+// showRow is a row of SHOW or information_schema output keyed by the
+// lower-cased column name.
+type showRow map[string]sql.NullString
+
+// get returns the value of a column, or "" if it is NULL or missing.
+func (r showRow) get(name string) string {
+	return r[name].String
+}
+
+// queryRows runs a query and returns all rows keyed by column name, so that
+// callers are not tied to the column order of a particular release.
+func (c *Client) queryRows(query string, args ...interface{}) ([]showRow, error) {
+	rows, err := c.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []showRow
+	for rows.Next() {
+		values := make([]sql.NullString, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+
+		row := make(showRow, len(cols))
+		for i, col := range cols {
+			row[strings.ToLower(col)] = values[i]
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
 func (c *Client) CreateResourceGroup(rg ResourceGroupModel) error {
-	query := fmt.Sprintf("CREATE RESOURCE GROUP %s", rg.GetName().ValueString())
+	query := "CREATE RESOURCE GROUP " + quoteIdentifier(rg.GetName().ValueString())
 
 	// Add TO clause with classifiers
-	if !rg.GetClassifiers().IsNull() && len(rg.GetClassifiers().Elements()) > 0 {
-		var classifierStrs []string
-		for _, elem := range rg.GetClassifiers().Elements() {
-			var conditions []string
-			if obj, ok := elem.(types.Object); ok {
-				attrs := obj.Attributes()
-				if user, exists := attrs["user"]; exists && !user.IsNull() {
-					if userStr, ok := user.(types.String); ok {
-						conditions = append(conditions, fmt.Sprintf("user='%s'", userStr.ValueString()))
-					}
-				}
-				if role, exists := attrs["role"]; exists && !role.IsNull() {
-					if roleStr, ok := role.(types.String); ok {
-						conditions = append(conditions, fmt.Sprintf("role='%s'", roleStr.ValueString()))
-					}
-				}
-				if queryType, exists := attrs["query_type"]; exists && !queryType.IsNull() {
-					if qtStr, ok := queryType.(types.String); ok {
-						conditions = append(conditions, fmt.Sprintf("query_type='%s'", qtStr.ValueString()))
-					}
-				}
-				if sourceIP, exists := attrs["source_ip"]; exists && !sourceIP.IsNull() {
-					if sipStr, ok := sourceIP.(types.String); ok {
-						conditions = append(conditions, fmt.Sprintf("source_ip='%s'", sipStr.ValueString()))
-					}
-				}
-				if db, exists := attrs["db"]; exists && !db.IsNull() {
-					if dbStr, ok := db.(types.String); ok {
-						conditions = append(conditions, fmt.Sprintf("db='%s'", dbStr.ValueString()))
-					}
-				}
-			}
-			if len(conditions) > 0 {
-				classifierStrs = append(classifierStrs, "("+strings.Join(conditions, ", ")+")")
-			}
-		}
-		if len(classifierStrs) > 0 {
-			query += " TO " + strings.Join(classifierStrs, ", ")
+	var classifierStrs []string
+	for _, classifier := range classifiersFromList(rg.GetClassifiers()) {
+		if conditions := classifierConditions(classifier); len(conditions) > 0 {
+			classifierStrs = append(classifierStrs, "("+strings.Join(conditions, ", ")+")")
 		}
 	}
+	if len(classifierStrs) > 0 {
+		query += " TO " + strings.Join(classifierStrs, ", ")
+	}
 
 	// Add WITH clause with properties
-	var props []string
-	if !rg.GetCPUWeight().IsNull() {
-		props = append(props, fmt.Sprintf("'cpu_weight' = '%d'", rg.GetCPUWeight().ValueInt64()))
+	if props := resourceGroupProperties(rg); len(props) > 0 {
+		query += " WITH (" + strings.Join(props, ", ") + ")"
 	}
-	if !rg.GetExclusiveCPUCores().IsNull() {
-		props = append(props, fmt.Sprintf("'exclusive_cpu_cores' = '%d'", rg.GetExclusiveCPUCores().ValueInt64()))
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// AlterResourceGroupProperties updates the given properties of an existing
+// resource group in place. Each property is a rendered "'key' = 'value'" pair.
+func (c *Client) AlterResourceGroupProperties(name string, props []string) error {
+	query := fmt.Sprintf("ALTER RESOURCE GROUP %s WITH (%s)", quoteIdentifier(name), strings.Join(props, ", "))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// AddResourceGroupClassifiers attaches additional classifiers to an existing
+// resource group.
+func (c *Client) AddResourceGroupClassifiers(name string, classifiers []Classifier) error {
+	var classifierStrs []string
+	for _, classifier := range classifiers {
+		if conditions := classifierConditions(classifier); len(conditions) > 0 {
+			classifierStrs = append(classifierStrs, "("+strings.Join(conditions, ", ")+")")
+		}
 	}
-	if !rg.GetCPUCoreLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'cpu_core_limit' = '%d'", rg.GetCPUCoreLimit().ValueInt64()))
+	if len(classifierStrs) == 0 {
+		return nil
 	}
-	if !rg.GetMaxCPUCores().IsNull() {
-		props = append(props, fmt.Sprintf("'max_cpu_cores' = '%d'", rg.GetMaxCPUCores().ValueInt64()))
+
+	query := fmt.Sprintf("ALTER RESOURCE GROUP %s ADD %s", quoteIdentifier(name), strings.Join(classifierStrs, ", "))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// DropResourceGroupClassifiers removes classifiers from a resource group by
+// their server-assigned IDs.
+func (c *Client) DropResourceGroupClassifiers(name string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
 	}
-	if !rg.GetMemLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'mem_limit' = '%s'", rg.GetMemLimit().ValueString()))
+
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.FormatInt(id, 10)
+	}
+
+	query := fmt.Sprintf("ALTER RESOURCE GROUP %s DROP (%s)", quoteIdentifier(name), strings.Join(idStrs, ", "))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// resourceGroupProperties renders the non-null properties of rg as
+// "'key' = 'value'" pairs for a WITH clause.
+func resourceGroupProperties(rg ResourceGroupModel) []string {
+	var props []string
+	for _, p := range resourceGroupPropertyValues(rg) {
+		if p.value != nil {
+			props = append(props, quoteProperty(p.key, *p.value))
+		}
 	}
-	if !rg.GetConcurrencyLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'concurrency_limit' = '%d'", rg.GetConcurrencyLimit().ValueInt64()))
+	return props
+}
+
+// changedResourceGroupProperties renders the properties that differ between
+// the before and after models. Properties removed from the configuration are
+// reset where possible, e.g. integer limits to 0, which StarRocks treats as
+// "no limit".
+func changedResourceGroupProperties(before, after ResourceGroupModel) []string {
+	oldProps := resourceGroupPropertyValues(before)
+	newProps := resourceGroupPropertyValues(after)
+
+	var props []string
+	for i, p := range newProps {
+		o := oldProps[i]
+		switch {
+		case p.value != nil && (o.value == nil || !p.equal(*o.value, *p.value)):
+			props = append(props, quoteProperty(p.key, *p.value))
+		case p.value == nil && o.value != nil && p.reset != nil:
+			props = append(props, quoteProperty(p.key, *p.reset))
+		}
 	}
-	if !rg.GetBigQueryMemLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'big_query_mem_limit' = '%d'", rg.GetBigQueryMemLimit().ValueInt64()))
+	return props
+}
+
+type resourceGroupProperty struct {
+	key   string
+	value *string
+	// reset is the value that restores the server default when the
+	// property is removed from the configuration, or nil if it cannot be
+	// reset.
+	reset *string
+	// same compares values, or nil to compare them as written.
+	same func(a, b string) bool
+}
+
+func (p resourceGroupProperty) equal(a, b string) bool {
+	if p.same != nil {
+		return p.same(a, b)
 	}
-	if !rg.GetBigQueryScanRowsLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'big_query_scan_rows_limit' = '%d'", rg.GetBigQueryScanRowsLimit().ValueInt64()))
+	return a == b
+}
+
+func resourceGroupPropertyValues(rg ResourceGroupModel) []resourceGroupProperty {
+	zero, empty := "0", ""
+	intProp := func(key string, v types.Int64) resourceGroupProperty {
+		p := resourceGroupProperty{key: key, reset: &zero}
+		if !v.IsNull() && !v.IsUnknown() {
+			s := strconv.FormatInt(v.ValueInt64(), 10)
+			p.value = &s
+		}
+		return p
 	}
-	if !rg.GetBigQueryCPUSecondLimit().IsNull() {
-		props = append(props, fmt.Sprintf("'big_query_cpu_second_limit' = '%d'", rg.GetBigQueryCPUSecondLimit().ValueInt64()))
+	percentProp := func(key string, v PercentageValue) resourceGroupProperty {
+		p := resourceGroupProperty{key: key, same: samePercentage}
+		if !v.IsNull() && !v.IsUnknown() {
+			s := v.ValueString()
+			p.value = &s
+		}
+		return p
 	}
 
-	if len(props) > 0 {
-		query += " WITH (" + strings.Join(props, ", ") + ")"
+	setProp := func(key string, v types.Set) resourceGroupProperty {
+		p := resourceGroupProperty{key: key, reset: &empty}
+		if !v.IsNull() && !v.IsUnknown() {
+			var items []string
+			for _, elem := range v.Elements() {
+				if s, ok := elem.(types.String); ok {
+					items = append(items, s.ValueString())
+				}
+			}
+			sort.Strings(items)
+			s := strings.Join(items, ",")
+			p.value = &s
+		}
+		return p
 	}
 
-	_, err := c.db.Exec(query)
-	return err
+	return []resourceGroupProperty{
+		intProp("cpu_weight", rg.GetCPUWeight()),
+		intProp("exclusive_cpu_cores", rg.GetExclusiveCPUCores()),
+		intProp("cpu_core_limit", rg.GetCPUCoreLimit()),
+		intProp("max_cpu_cores", rg.GetMaxCPUCores()),
+		percentProp("mem_limit", rg.GetMemLimit()),
+		intProp("concurrency_limit", rg.GetConcurrencyLimit()),
+		intProp("big_query_mem_limit", rg.GetBigQueryMemLimit()),
+		intProp("big_query_scan_rows_limit", rg.GetBigQueryScanRowsLimit()),
+		intProp("big_query_cpu_second_limit", rg.GetBigQueryCPUSecondLimit()),
+		percentProp("spill_mem_limit_threshold", rg.GetSpillMemLimitThreshold()),
+		setProp("warehouses", rg.GetWarehouses()),
+	}
 }
 
-func (c *Client) GetResourceGroup(name string) (*ResourceGroup, error) {
-	query := fmt.Sprintf("SHOW RESOURCE GROUP %s", name)
-	rows, err := c.db.Query(query)
-	if err != nil {
-		return nil, err
+// classifiersFromList converts the classifiers attribute of a resource group
+// model into Classifier values.
+func classifiersFromList(list types.List) []Classifier {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
 	}
-	defer rows.Close()
 
-	cols, err := rows.Columns()
-	if err != nil {
-		return nil, err
+	var classifiers []Classifier
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		str := func(name string) types.String {
+			if v, ok := attrs[name].(types.String); ok {
+				return v
+			}
+			return types.StringNull()
+		}
+		queryType, ok := attrs["query_type"].(types.Set)
+		if !ok {
+			queryType = types.SetNull(types.StringType)
+		}
+		classifiers = append(classifiers, Classifier{
+			User:             str("user"),
+			Role:             str("role"),
+			QueryType:        queryType,
+			SourceIP:         str("source_ip"),
+			DB:               str("db"),
+			PlanCPUCostRange: str("plan_cpu_cost_range"),
+			PlanMemCostRange: str("plan_mem_cost_range"),
+		})
 	}
+	return classifiers
+}
 
-	colIndex := make(map[string]int, len(cols))
-	for i, col := range cols {
-		colIndex[strings.ToLower(col)] = i
+// classifiersToList converts classifiers into the value of the classifiers
+// attribute. An empty slice is represented as a null list.
+func classifiersToList(classifiers []Classifier) types.List {
+	objType := types.ObjectType{AttrTypes: classifierAttrTypes}
+	if len(classifiers) == 0 {
+		return types.ListNull(objType)
 	}
 
-	rg := &ResourceGroup{Name: types.StringValue(name)}
-	var classifiers []Classifier
+	elems := make([]attr.Value, 0, len(classifiers))
+	for _, c := range classifiers {
+		queryType := c.QueryType
+		if queryType.IsNull() {
+			queryType = types.SetNull(types.StringType)
+		}
+		elems = append(elems, types.ObjectValueMust(classifierAttrTypes, map[string]attr.Value{
+			"user":                c.User,
+			"role":                c.Role,
+			"query_type":          queryType,
+			"source_ip":           c.SourceIP,
+			"db":                  c.DB,
+			"plan_cpu_cost_range": c.PlanCPUCostRange,
+			"plan_mem_cost_range": c.PlanMemCostRange,
+		}))
+	}
+	return types.ListValueMust(objType, elems)
+}
 
-	for rows.Next() {
-		values := make([]string, len(cols))
-		ptrs := make([]interface{}, len(cols))
-		for i := range values {
-			ptrs[i] = &values[i]
+// queryTypes returns the sorted, lower-cased query types of a classifier.
+func queryTypes(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var values []string
+	for _, elem := range set.Elements() {
+		if v, ok := elem.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			values = append(values, strings.ToLower(v.ValueString()))
 		}
+	}
+	sort.Strings(values)
+	return values
+}
 
-		if err := rows.Scan(ptrs...); err != nil {
-			return nil, err
+// stringSetValues returns the known elements of a string set, sorted.
+func stringSetValues(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var values []string
+	for _, elem := range set.Elements() {
+		if v, ok := elem.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			values = append(values, v.ValueString())
 		}
+	}
+	sort.Strings(values)
+	return values
+}
 
-		getCol := func(name string) string {
-			if idx, ok := colIndex[name]; ok {
-				return values[idx]
-			}
-			return ""
+// diffStrings returns the values of after missing from before, and the
+// values of before missing from after.
+func diffStrings(before, after []string) (added, removed []string) {
+	inBefore := make(map[string]bool, len(before))
+	for _, v := range before {
+		inBefore[v] = true
+	}
+	inAfter := make(map[string]bool, len(after))
+	for _, v := range after {
+		inAfter[v] = true
+		if !inBefore[v] {
+			added = append(added, v)
+		}
+	}
+	for _, v := range before {
+		if !inAfter[v] {
+			removed = append(removed, v)
 		}
+	}
+	return added, removed
+}
 
-		if rg.MemLimit.IsNull() {
-			if v := getCol("mem_limit"); v != "" {
-				rg.MemLimit = types.StringValue(v)
-			}
+// normalizeCostRange renders a plan cost range such as "[1.0, 100.0)" in a
+// canonical form so that equivalent ranges compare equal. Ranges that cannot
+// be parsed are returned unchanged.
+func normalizeCostRange(s string) string {
+	lb, lo, hi, rb, ok := parseCostRange(s)
+	if !ok {
+		return s
+	}
+	return fmt.Sprintf("%c%s, %s%c", lb, strconv.FormatFloat(lo, 'f', -1, 64), strconv.FormatFloat(hi, 'f', -1, 64), rb)
+}
+
+// parseCostRange splits a plan cost range into its brackets and bounds.
+func parseCostRange(s string) (lb byte, lo, hi float64, rb byte, ok bool) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 {
+		return 0, 0, 0, 0, false
+	}
+
+	lb, rb = s[0], s[len(s)-1]
+	lower, upper, found := strings.Cut(s[1:len(s)-1], ",")
+	if !found || (lb != '[' && lb != '(') || (rb != ']' && rb != ')') {
+		return 0, 0, 0, 0, false
+	}
+
+	lo, err := strconv.ParseFloat(strings.TrimSpace(lower), 64)
+	if err != nil {
+		return 0, 0, 0, 0, false
+	}
+	hi, err = strconv.ParseFloat(strings.TrimSpace(upper), 64)
+	if err != nil {
+		return 0, 0, 0, 0, false
+	}
+	return lb, lo, hi, rb, true
+}
+
+// classifierConditions renders the conditions of a classifier as used in the
+// TO and ADD clauses.
+func classifierConditions(c Classifier) []string {
+	var conditions []string
+	if !c.User.IsNull() {
+		conditions = append(conditions, "user="+quoteString(c.User.ValueString()))
+	}
+	if !c.Role.IsNull() {
+		conditions = append(conditions, "role="+quoteString(c.Role.ValueString()))
+	}
+	if qts := queryTypes(c.QueryType); len(qts) > 0 {
+		conditions = append(conditions, "query_type in ("+quoteStrings(qts)+")")
+	}
+	if !c.SourceIP.IsNull() {
+		conditions = append(conditions, "source_ip="+quoteString(c.SourceIP.ValueString()))
+	}
+	if !c.DB.IsNull() {
+		conditions = append(conditions, "db="+quoteString(c.DB.ValueString()))
+	}
+	if !c.PlanCPUCostRange.IsNull() {
+		conditions = append(conditions, "plan_cpu_cost_range="+quoteString(c.PlanCPUCostRange.ValueString()))
+	}
+	if !c.PlanMemCostRange.IsNull() {
+		conditions = append(conditions, "plan_mem_cost_range="+quoteString(c.PlanMemCostRange.ValueString()))
+	}
+	return conditions
+}
+
+// classifierKey identifies a classifier by its conditions, ignoring the ID
+// and the formatting of cost ranges.
+func classifierKey(c Classifier) string {
+	if !c.PlanCPUCostRange.IsNull() {
+		c.PlanCPUCostRange = types.StringValue(normalizeCostRange(c.PlanCPUCostRange.ValueString()))
+	}
+	if !c.PlanMemCostRange.IsNull() {
+		c.PlanMemCostRange = types.StringValue(normalizeCostRange(c.PlanMemCostRange.ValueString()))
+	}
+	return strings.Join(classifierConditions(c), ", ")
+}
+
+// mergeClassifiers reconciles the classifiers read from the server with those
+// in the prior state. Classifiers that are still present keep their position
+// and formatting from the state; new ones are appended in server order, and
+// ones missing from the server are dropped.
+func mergeClassifiers(prior, current []Classifier) []Classifier {
+	byKey := make(map[string][]Classifier)
+	var order []string
+	for _, c := range current {
+		key := classifierKey(c)
+		if _, seen := byKey[key]; !seen {
+			order = append(order, key)
 		}
-		if rg.ConcurrencyLimit.IsNull() {
-			if v, err := strconv.ParseInt(getCol("concurrency_limit"), 10, 64); err == nil {
-				rg.ConcurrencyLimit = types.Int64Value(v)
-			}
+		byKey[key] = append(byKey[key], c)
+	}
+
+	var merged []Classifier
+	for _, c := range prior {
+		key := classifierKey(c)
+		if candidates := byKey[key]; len(candidates) > 0 {
+			c.ID = candidates[0].ID
+			merged = append(merged, c)
+			byKey[key] = candidates[1:]
 		}
-		if rg.BigQueryMemLimit.IsNull() {
-			if v, err := strconv.ParseInt(getCol("big_query_mem_limit"), 10, 64); err == nil {
-				rg.BigQueryMemLimit = types.Int64Value(v)
-			}
+	}
+	for _, key := range order {
+		merged = append(merged, byKey[key]...)
+	}
+	return merged
+}
+
+// diffClassifiers returns the classifiers that are present only in after
+// (added) and only in before (removed). Duplicates are counted individually.
+func diffClassifiers(before, after []Classifier) (added, removed []Classifier) {
+	remaining := make(map[string]int)
+	for _, c := range before {
+		remaining[classifierKey(c)]++
+	}
+	for _, c := range after {
+		key := classifierKey(c)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
 		}
-		if rg.BigQueryScanRowsLimit.IsNull() {
-			if v, err := strconv.ParseInt(getCol("big_query_scan_rows_limit"), 10, 64); err == nil {
-				rg.BigQueryScanRowsLimit = types.Int64Value(v)
-			}
+		added = append(added, c)
+	}
+	for _, c := range before {
+		key := classifierKey(c)
+		if remaining[key] > 0 {
+			remaining[key]--
+			removed = append(removed, c)
 		}
-		if rg.BigQueryCPUSecondLimit.IsNull() {
-			if v, err := strconv.ParseInt(getCol("big_query_cpu_second_limit"), 10, 64); err == nil {
-				rg.BigQueryCPUSecondLimit = types.Int64Value(v)
-			}
+	}
+	return added, removed
+}
+
+// matchClassifierIDs looks up the server-assigned IDs of the wanted
+// classifiers among the classifiers currently attached to a group.
+func matchClassifierIDs(current, wanted []Classifier) []int64 {
+	byKey := make(map[string][]int64)
+	for _, c := range current {
+		key := classifierKey(c)
+		byKey[key] = append(byKey[key], c.ID)
+	}
+
+	var ids []int64
+	for _, c := range wanted {
+		key := classifierKey(c)
+		if candidates := byKey[key]; len(candidates) > 0 {
+			ids = append(ids, candidates[0])
+			byKey[key] = candidates[1:]
 		}
+	}
+	return ids
+}
+
+func (c *Client) GetResourceGroup(name string) (*ResourceGroup, error) {
+	rows, err := c.queryRows("SHOW RESOURCE GROUP " + quoteIdentifier(name))
+	if err != nil {
+		return nil, wrapNotFound(err, "resource group", name)
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "resource group", Name: name}
+	}
+
+	// Each row describes one classifier; the group properties are repeated
+	// on every row, so they are taken from the first one.
+	first := rows[0]
+	rg := &ResourceGroup{
+		Name:                   types.StringValue(name),
+		ID:                     parseInt64Column(first.get("id"), false),
+		CPUWeight:              parseInt64Column(first.get("cpu_weight"), true),
+		ExclusiveCPUCores:      parseInt64Column(first.get("exclusive_cpu_cores"), true),
+		CPUCoreLimit:           parseInt64Column(first.get("cpu_core_limit"), true),
+		MaxCPUCores:            parseInt64Column(first.get("max_cpu_cores"), true),
+		MemLimit:               PercentageValue{StringValue: parseStringColumn(first.get("mem_limit"))},
+		ConcurrencyLimit:       parseInt64Column(first.get("concurrency_limit"), true),
+		BigQueryMemLimit:       parseInt64Column(first.get("big_query_mem_limit"), true),
+		BigQueryScanRowsLimit:  parseInt64Column(first.get("big_query_scan_rows_limit"), true),
+		BigQueryCPUSecondLimit: parseInt64Column(first.get("big_query_cpu_second_limit"), true),
+		SpillMemLimitThreshold: PercentageValue{StringValue: parseStringColumn(first.get("spill_mem_limit_threshold"))},
+		Warehouses:             parseListColumn(first.get("warehouses")),
+	}
 
-		if classifiersStr := getCol("classifiers"); classifiersStr != "" {
-			classifier := parseClassifier(classifiersStr)
-			classifiers = append(classifiers, classifier)
+	for _, row := range rows {
+		if classifiersStr := row.get("classifiers"); classifiersStr != "" {
+			rg.classifiers = append(rg.classifiers, parseClassifier(classifiersStr))
 		}
 	}
 
+	rg.Classifiers = classifiersToList(rg.classifiers)
+
 	return rg, nil
 }
 
-func parseClassifier(s string) Classifier {
-	re := regexp.MustCompile(`id=(\d+).*?user=([^,)]+)|role=([^,)]+)|query_type=([^,)]+)|source_ip=([^,)]+)|db=([^,)]+)`)
-	matches := re.FindStringSubmatch(s)
-	c := Classifier{}
-	if len(matches) > 1 {
-		c.ID, _ = strconv.ParseInt(matches[1], 10, 64)
+// parseInt64Column parses an integer column of SHOW output. Empty or
+// unparsable values are null; with zeroIsNull, 0 is treated as unset, which
+// is how StarRocks reports limits that were never configured.
+func parseInt64Column(s string, zeroIsNull bool) types.Int64 {
+	v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || (zeroIsNull && v == 0) {
+		return types.Int64Null()
 	}
-	if len(matches) > 2 && matches[2] != "" {
-		c.User = types.StringValue(matches[2])
+	return types.Int64Value(v)
+}
+
+// parseStringColumn returns a string column of SHOW output, with empty
+// values treated as null.
+func parseStringColumn(s string) types.String {
+	if s = strings.TrimSpace(s); s == "" {
+		return types.StringNull()
 	}
-	if len(matches) > 3 && matches[3] != "" {
-		c.Role = types.StringValue(matches[3])
+	return types.StringValue(s)
+}
+
+// parseListColumn parses a comma-separated column of SHOW output into a set
+// of strings, with empty values treated as null.
+func parseListColumn(s string) types.Set {
+	var elems []attr.Value
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			elems = append(elems, types.StringValue(item))
+		}
 	}
-	if len(matches) > 4 && matches[4] != "" {
-		c.QueryType = types.StringValue(matches[4])
+	if len(elems) == 0 {
+		return types.SetNull(types.StringType)
 	}
-	if len(matches) > 5 && matches[5] != "" {
-		c.SourceIP = types.StringValue(matches[5])
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// parseClassifier parses one entry of the classifiers column of SHOW RESOURCE
+// GROUP, e.g. "(id=1, weight=1.0, user=u1, query_type in (SELECT), db='db1')".
+func parseClassifier(s string) Classifier {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = s[1 : len(s)-1]
 	}
-	if len(matches) > 6 && matches[6] != "" {
-		c.DB = types.StringValue(matches[6])
+
+	c := Classifier{
+		User:             types.StringNull(),
+		Role:             types.StringNull(),
+		QueryType:        types.SetNull(types.StringType),
+		SourceIP:         types.StringNull(),
+		DB:               types.StringNull(),
+		PlanCPUCostRange: types.StringNull(),
+		PlanMemCostRange: types.StringNull(),
+	}
+	for _, field := range splitClassifierFields(s) {
+		key, value := cutClassifierField(field)
+		switch key {
+		case "id":
+			c.ID, _ = strconv.ParseInt(value, 10, 64)
+		case "user":
+			c.User = types.StringValue(value)
+		case "role":
+			c.Role = types.StringValue(value)
+		case "query_type":
+			var elems []attr.Value
+			for _, qt := range strings.Split(value, ",") {
+				qt = strings.ToLower(strings.Trim(strings.TrimSpace(qt), "'"))
+				if qt != "" {
+					elems = append(elems, types.StringValue(qt))
+				}
+			}
+			c.QueryType = types.SetValueMust(types.StringType, elems)
+		case "source_ip":
+			c.SourceIP = types.StringValue(value)
+		case "db":
+			c.DB = types.StringValue(value)
+		case "plan_cpu_cost_range":
+			c.PlanCPUCostRange = types.StringValue(value)
+		case "plan_mem_cost_range":
+			c.PlanMemCostRange = types.StringValue(value)
+		}
 	}
 	return c
 }
 
+// splitClassifierFields splits a classifier on the commas that separate its
+// fields, skipping commas nested in parentheses, brackets or quotes.
+func splitClassifierFields(s string) []string {
+	var fields []string
+	depth, start, quoted := 0, 0, false
+	for i, r := range s {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(' || r == '[':
+			depth++
+		case r == ')' || r == ']':
+			depth--
+		case r == ',' && depth == 0:
+			fields = append(fields, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if rest := strings.TrimSpace(s[start:]); rest != "" {
+		fields = append(fields, rest)
+	}
+	return fields
+}
+
+// cutClassifierField splits "key=value" or "key in (a, b)" into its key and
+// unquoted value.
+func cutClassifierField(field string) (string, string) {
+	end := strings.IndexFunc(field, func(r rune) bool {
+		return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	if end < 0 {
+		return strings.ToLower(field), ""
+	}
+
+	key := strings.ToLower(field[:end])
+	rest := strings.TrimSpace(field[end:])
+	switch {
+	case strings.HasPrefix(rest, "="):
+		rest = strings.TrimSpace(rest[1:])
+	case len(rest) > 2 && strings.EqualFold(rest[:2], "in"):
+		rest = strings.TrimSpace(rest[2:])
+		return key, strings.TrimSuffix(strings.TrimPrefix(rest, "("), ")")
+	}
+	return key, strings.Trim(rest, "'")
+}
+
 func (c *Client) DeleteResourceGroup(name string) error {
-	query := fmt.Sprintf("DROP RESOURCE GROUP %s", name)
+	query := "DROP RESOURCE GROUP " + quoteIdentifier(name)
 	_, err := c.db.Exec(query)
-	return err
+	return wrapNotFound(err, "resource group", name)
 }
@@ -0,0 +1,312 @@
+package starrocks
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// External catalog types.
+const (
+	catalogTypeHive      = "hive"
+	catalogTypeIceberg   = "iceberg"
+	catalogTypeHudi      = "hudi"
+	catalogTypeDeltaLake = "deltalake"
+	catalogTypeJDBC      = "jdbc"
+	catalogTypePaimon    = "paimon"
+)
+
+var catalogTypes = []string{
+	catalogTypeHive, catalogTypeIceberg, catalogTypeHudi, catalogTypeDeltaLake, catalogTypeJDBC, catalogTypePaimon,
+}
+
+// catalogField maps an attribute of a typed catalog block to the catalog
+// property it sets.
+type catalogField struct {
+	Attr      string
+	Key       string
+	Bool      bool
+	Sensitive bool
+}
+
+// catalogBlockFields lists the attributes of the credential and connection
+// blocks of starrocks_external_catalog. The metastore block depends on the
+// catalog type; see metastoreFields.
+var catalogBlockFields = map[string][]catalogField{
+	"glue": {
+		{Attr: "region", Key: "aws.glue.region"},
+		{Attr: "use_instance_profile", Key: "aws.glue.use_instance_profile", Bool: true},
+		{Attr: "iam_role_arn", Key: "aws.glue.iam_role_arn"},
+		{Attr: "access_key", Key: "aws.glue.access_key", Sensitive: true},
+		{Attr: "secret_key", Key: "aws.glue.secret_key", Sensitive: true},
+	},
+	"s3": {
+		{Attr: "region", Key: "aws.s3.region"},
+		{Attr: "endpoint", Key: "aws.s3.endpoint"},
+		{Attr: "use_instance_profile", Key: "aws.s3.use_instance_profile", Bool: true},
+		{Attr: "iam_role_arn", Key: "aws.s3.iam_role_arn"},
+		{Attr: "enable_path_style_access", Key: "aws.s3.enable_path_style_access", Bool: true},
+		{Attr: "enable_ssl", Key: "aws.s3.enable_ssl", Bool: true},
+		{Attr: "access_key", Key: "aws.s3.access_key", Sensitive: true},
+		{Attr: "secret_key", Key: "aws.s3.secret_key", Sensitive: true},
+	},
+	"gcs": {
+		{Attr: "use_compute_engine_service_account", Key: "gcp.gcs.use_compute_engine_service_account", Bool: true},
+		{Attr: "service_account_email", Key: "gcp.gcs.service_account_email"},
+		{Attr: "impersonation_service_account", Key: "gcp.gcs.impersonation_service_account"},
+		{Attr: "service_account_private_key_id", Key: "gcp.gcs.service_account_private_key_id", Sensitive: true},
+		{Attr: "service_account_private_key", Key: "gcp.gcs.service_account_private_key", Sensitive: true},
+	},
+	"azure": {
+		{Attr: "storage_account", Key: "azure.blob.storage_account"},
+		{Attr: "container", Key: "azure.blob.container"},
+		{Attr: "shared_key", Key: "azure.blob.shared_key", Sensitive: true},
+		{Attr: "sas_token", Key: "azure.blob.sas_token", Sensitive: true},
+	},
+	"jdbc": {
+		{Attr: "uri", Key: "jdbc_uri"},
+		{Attr: "user", Key: "user"},
+		{Attr: "driver_url", Key: "driver_url"},
+		{Attr: "driver_class", Key: "driver_class"},
+		{Attr: "password", Key: "password", Sensitive: true},
+	},
+}
+
+// immutableCatalogBlocks are the blocks whose properties cannot be changed
+// with ALTER CATALOG: the Glue metastore and the JDBC connection.
+var immutableCatalogBlocks = map[string]bool{"glue": true, "jdbc": true}
+
+// catalogPropertyMutable reports whether ALTER CATALOG can change a property.
+// The catalog type, metastore and JDBC connection are fixed at creation.
+func catalogPropertyMutable(key string) bool {
+	if key == "type" {
+		return false
+	}
+	for _, catalogType := range catalogTypes {
+		for _, metastoreType := range []string{"", "rest"} {
+			for _, f := range metastoreFields(catalogType, metastoreType) {
+				if f.Key == key {
+					return false
+				}
+			}
+		}
+	}
+	for name := range immutableCatalogBlocks {
+		for _, f := range catalogBlockFields[name] {
+			if f.Key == key {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// metastoreAttrTypes are the attributes of the metastore block.
+var metastoreAttrTypes = map[string]attr.Type{
+	"type":      types.StringType,
+	"uri":       types.StringType,
+	"warehouse": types.StringType,
+}
+
+// metastoreFields returns the properties set by the metastore block of a
+// catalog, which are named after the catalog type. JDBC catalogs have no
+// metastore.
+func metastoreFields(catalogType, metastoreType string) []catalogField {
+	switch catalogType {
+	case catalogTypeJDBC:
+		return nil
+	case catalogTypeIceberg:
+		uri := "iceberg.catalog.hive.metastore.uris"
+		if metastoreType == "rest" {
+			uri = "iceberg.catalog.uri"
+		}
+		return []catalogField{
+			{Attr: "type", Key: "iceberg.catalog.type"},
+			{Attr: "uri", Key: uri},
+			{Attr: "warehouse", Key: "iceberg.catalog.warehouse"},
+		}
+	case catalogTypePaimon:
+		return []catalogField{
+			{Attr: "type", Key: "paimon.catalog.type"},
+			{Attr: "uri", Key: "hive.metastore.uris"},
+			{Attr: "warehouse", Key: "paimon.catalog.warehouse"},
+		}
+	}
+	return []catalogField{
+		{Attr: "type", Key: "hive.metastore.type"},
+		{Attr: "uri", Key: "hive.metastore.uris"},
+	}
+}
+
+type ExternalCatalog struct {
+	Name       types.String
+	Comment    types.String
+	Properties map[string]string
+}
+
+// catalogBlockAttrTypes returns the attribute types of a typed block.
+func catalogBlockAttrTypes(fields []catalogField) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(fields))
+	for _, f := range fields {
+		if f.Bool {
+			attrTypes[f.Attr] = types.BoolType
+		} else {
+			attrTypes[f.Attr] = types.StringType
+		}
+	}
+	return attrTypes
+}
+
+// catalogBlockProperties adds the properties set by a typed block to props.
+func catalogBlockProperties(obj types.Object, fields []catalogField, props map[string]string) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+
+	attrs := obj.Attributes()
+	for _, f := range fields {
+		switch v := attrs[f.Attr].(type) {
+		case types.String:
+			if !v.IsNull() && !v.IsUnknown() {
+				props[f.Key] = v.ValueString()
+			}
+		case types.Bool:
+			if !v.IsNull() && !v.IsUnknown() {
+				props[f.Key] = strconv.FormatBool(v.ValueBool())
+			}
+		}
+	}
+}
+
+// catalogBlockFromProperties builds a typed block from catalog properties.
+// Sensitive attributes, and attributes without a property, keep their
+// values from prior since the server masks secrets.
+func catalogBlockFromProperties(prior types.Object, attrTypes map[string]attr.Type, fields []catalogField, props map[string]string) types.Object {
+	var priorAttrs map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorAttrs = prior.Attributes()
+	}
+
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		if v, ok := priorAttrs[name]; ok {
+			attrs[name] = v
+		} else if t == types.BoolType {
+			attrs[name] = types.BoolNull()
+		} else {
+			attrs[name] = types.StringNull()
+		}
+	}
+
+	for _, f := range fields {
+		if f.Sensitive {
+			continue
+		}
+		v, ok := props[f.Key]
+		switch {
+		case !ok:
+			if f.Bool {
+				attrs[f.Attr] = types.BoolNull()
+			} else {
+				attrs[f.Attr] = types.StringNull()
+			}
+		case f.Bool:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				attrs[f.Attr] = types.BoolNull()
+			} else {
+				attrs[f.Attr] = types.BoolValue(b)
+			}
+		default:
+			attrs[f.Attr] = types.StringValue(v)
+		}
+	}
+
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+// hasCatalogProperties reports whether props sets any non-sensitive field.
+func hasCatalogProperties(fields []catalogField, props map[string]string) bool {
+	for _, f := range fields {
+		if _, ok := props[f.Key]; ok && !f.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// isMaskedValue reports whether a property value was masked by the server,
+// e.g. "******".
+func isMaskedValue(v string) bool {
+	return v != "" && strings.Trim(v, "*") == ""
+}
+
+func (c *Client) CreateExternalCatalog(name string, comment types.String, props map[string]string) error {
+	query := "CREATE EXTERNAL CATALOG " + quoteIdentifier(name)
+	if !comment.IsNull() && !comment.IsUnknown() {
+		query += " COMMENT " + quoteString(comment.ValueString())
+	}
+	query += quotePropertiesClause(props)
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetCatalogProperties changes properties of an external catalog. Properties
+// fixed at creation are rejected; see catalogPropertyMutable.
+func (c *Client) SetCatalogProperties(name string, props map[string]string) error {
+	if len(props) == 0 {
+		return nil
+	}
+	for k := range props {
+		if !catalogPropertyMutable(k) {
+			return fmt.Errorf("catalog property %q cannot be changed", k)
+		}
+	}
+
+	query := fmt.Sprintf("ALTER CATALOG %s SET (%s)", quoteIdentifier(name), quotePropertyList(props))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "catalog", name)
+}
+
+var catalogCommentPattern = regexp.MustCompile(`(?im)^comment\s+`)
+
+// GetExternalCatalog reads an external catalog with SHOW CREATE CATALOG.
+// Secrets are masked in the returned properties.
+func (c *Client) GetExternalCatalog(name string) (*ExternalCatalog, error) {
+	var catalogName, ddl string
+	if err := c.db.QueryRow("SHOW CREATE CATALOG "+quoteIdentifier(name)).Scan(&catalogName, &ddl); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NotFoundError{Kind: "catalog", Name: name}
+		}
+		return nil, wrapNotFound(err, "catalog", name)
+	}
+
+	catalog := &ExternalCatalog{
+		Name:       types.StringValue(name),
+		Comment:    types.StringNull(),
+		Properties: parseProperties(ddl),
+	}
+
+	head := ddl
+	if idx := strings.Index(strings.ToUpper(ddl), "PROPERTIES"); idx >= 0 {
+		head = ddl[:idx]
+	}
+	if loc := catalogCommentPattern.FindStringIndex(head); loc != nil {
+		if comment, _, ok := nextStringLiteral(head[loc[1]:]); ok && comment != "" {
+			catalog.Comment = types.StringValue(comment)
+		}
+	}
+
+	return catalog, nil
+}
+
+func (c *Client) DropExternalCatalog(name string) error {
+	_, err := c.db.Exec("DROP CATALOG " + quoteIdentifier(name))
+	return wrapNotFound(err, "catalog", name)
+}
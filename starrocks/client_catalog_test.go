@@ -0,0 +1,223 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMetastoreFields(t *testing.T) {
+	keys := func(fields []catalogField) []string {
+		var keys []string
+		for _, f := range fields {
+			keys = append(keys, f.Key)
+		}
+		return keys
+	}
+
+	tests := []struct {
+		catalogType, metastoreType string
+		want                       []string
+	}{
+		{catalogTypeHive, "hive", []string{"hive.metastore.type", "hive.metastore.uris"}},
+		{catalogTypeIceberg, "hive", []string{"iceberg.catalog.type", "iceberg.catalog.hive.metastore.uris", "iceberg.catalog.warehouse"}},
+		{catalogTypeIceberg, "rest", []string{"iceberg.catalog.type", "iceberg.catalog.uri", "iceberg.catalog.warehouse"}},
+		{catalogTypePaimon, "filesystem", []string{"paimon.catalog.type", "hive.metastore.uris", "paimon.catalog.warehouse"}},
+		{catalogTypeJDBC, "", nil},
+	}
+
+	for _, tt := range tests {
+		if got := keys(metastoreFields(tt.catalogType, tt.metastoreType)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("metastoreFields(%q, %q) = %v, want %v", tt.catalogType, tt.metastoreType, got, tt.want)
+		}
+	}
+}
+
+func TestExternalCatalogProperties(t *testing.T) {
+	s3Types := catalogBlockAttrTypes(catalogBlockFields["s3"])
+	model := externalCatalogResourceModel{
+		Name: types.StringValue("hive_lake"),
+		Type: types.StringValue(catalogTypeHive),
+		Metastore: types.ObjectValueMust(metastoreAttrTypes, map[string]attr.Value{
+			"type":      types.StringValue("hive"),
+			"uri":       types.StringValue("thrift://metastore:9083"),
+			"warehouse": types.StringNull(),
+		}),
+		S3: types.ObjectValueMust(s3Types, map[string]attr.Value{
+			"region":                   types.StringValue("us-west-2"),
+			"endpoint":                 types.StringNull(),
+			"use_instance_profile":     types.BoolValue(false),
+			"iam_role_arn":             types.StringNull(),
+			"enable_path_style_access": types.BoolNull(),
+			"enable_ssl":               types.BoolNull(),
+			"access_key":               types.StringValue("AKIA"),
+			"secret_key":               types.StringValue("s3cr3t"),
+		}),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"enable_metastore_cache": types.StringValue("true"),
+		}),
+	}
+	for name, obj := range model.blocks() {
+		if name != "s3" {
+			*obj = types.ObjectNull(catalogBlockAttrTypes(catalogBlockFields[name]))
+		}
+	}
+
+	want := map[string]string{
+		"type":                        "hive",
+		"hive.metastore.type":         "hive",
+		"hive.metastore.uris":         "thrift://metastore:9083",
+		"aws.s3.region":               "us-west-2",
+		"aws.s3.use_instance_profile": "false",
+		"aws.s3.access_key":           "AKIA",
+		"aws.s3.secret_key":           "s3cr3t",
+		"enable_metastore_cache":      "true",
+	}
+	if got := model.catalogProperties(); !reflect.DeepEqual(got, want) {
+		t.Errorf("catalogProperties = %v, want %v", got, want)
+	}
+
+	// Masked secrets keep their configured values; other attributes follow
+	// the server.
+	diags := model.refresh(&ExternalCatalog{
+		Name:    types.StringValue("hive_lake"),
+		Comment: types.StringNull(),
+		Properties: map[string]string{
+			"type":                        "hive",
+			"hive.metastore.type":         "hive",
+			"hive.metastore.uris":         "thrift://metastore-2:9083",
+			"aws.s3.region":               "us-west-2",
+			"aws.s3.use_instance_profile": "false",
+			"aws.s3.access_key":           "******",
+			"aws.s3.secret_key":           "******",
+			"enable_metastore_cache":      "false",
+		},
+	}, false)
+	if diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+
+	s3 := model.S3.Attributes()
+	if s3["secret_key"].(types.String).ValueString() != "s3cr3t" || s3["access_key"].(types.String).ValueString() != "AKIA" {
+		t.Errorf("secrets were not kept: %v", s3)
+	}
+	if uri := model.Metastore.Attributes()["uri"].(types.String).ValueString(); uri != "thrift://metastore-2:9083" {
+		t.Errorf("metastore uri = %q", uri)
+	}
+	if props := mapValues(model.Properties); props["enable_metastore_cache"] != "false" {
+		t.Errorf("Properties = %v", props)
+	}
+	if !model.Glue.IsNull() {
+		t.Errorf("Glue = %v, want null", model.Glue)
+	}
+}
+
+func TestCreateExternalCatalog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE EXTERNAL CATALOG `hive_lake` COMMENT 'lake' PROPERTIES ('hive.metastore.uris' = 'thrift://metastore:9083', 'type' = 'hive')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER CATALOG `hive_lake` SET ('aws.s3.secret_key' = 'new', 'enable_metastore_cache' = 'false')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DROP CATALOG `hive_lake`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreateExternalCatalog("hive_lake", types.StringValue("lake"), map[string]string{
+		"type":                "hive",
+		"hive.metastore.uris": "thrift://metastore:9083",
+	})
+	if err != nil {
+		t.Fatalf("CreateExternalCatalog failed: %v", err)
+	}
+	if err := client.SetCatalogProperties("hive_lake", nil); err != nil {
+		t.Fatalf("SetCatalogProperties failed: %v", err)
+	}
+	err = client.SetCatalogProperties("hive_lake", map[string]string{
+		"enable_metastore_cache": "false",
+		"aws.s3.secret_key":      "new",
+	})
+	if err != nil {
+		t.Fatalf("SetCatalogProperties failed: %v", err)
+	}
+	if err := client.SetCatalogProperties("hive_lake", map[string]string{"hive.metastore.uris": "thrift://other:9083"}); err == nil {
+		t.Error("SetCatalogProperties accepted an immutable property")
+	}
+	if err := client.DropExternalCatalog("hive_lake"); err != nil {
+		t.Fatalf("DropExternalCatalog failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetExternalCatalog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE CATALOG `hive_lake`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Catalog", "Create Catalog"}).AddRow(
+			"hive_lake",
+			"CREATE EXTERNAL CATALOG `hive_lake`\ncomment \"lake\"\nPROPERTIES (\"aws.s3.access_key\"  =  \"******\",\n\"hive.metastore.uris\"  =  \"thrift://metastore:9083\",\n\"type\"  =  \"hive\"\n)",
+		),
+	)
+
+	got, err := client.GetExternalCatalog("hive_lake")
+	if err != nil {
+		t.Fatalf("GetExternalCatalog failed: %v", err)
+	}
+	if got.Comment.ValueString() != "lake" {
+		t.Errorf("Comment = %v, want lake", got.Comment)
+	}
+	want := map[string]string{
+		"aws.s3.access_key":   "******",
+		"hive.metastore.uris": "thrift://metastore:9083",
+		"type":                "hive",
+	}
+	if !reflect.DeepEqual(got.Properties, want) {
+		t.Errorf("Properties = %v, want %v", got.Properties, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestCatalogPropertyMutable(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"type", false},
+		{"hive.metastore.uris", false},
+		{"iceberg.catalog.uri", false},
+		{"paimon.catalog.warehouse", false},
+		{"aws.glue.region", false},
+		{"jdbc_uri", false},
+		{"password", false},
+		{"aws.s3.secret_key", true},
+		{"azure.blob.sas_token", true},
+		{"enable_metastore_cache", true},
+	}
+
+	for _, tt := range tests {
+		if got := catalogPropertyMutable(tt.key); got != tt.want {
+			t.Errorf("catalogPropertyMutable(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package starrocks
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultReplicaQuota is the replica quota StarRocks assigns to new
+// databases. Together with an unlimited data quota it is reported as unset.
+const defaultReplicaQuota int64 = 1024 * 1024 * 1024
+
+type Database struct {
+	Catalog        types.String
+	Name           types.String
+	ReplicationNum types.Int64
+	StorageVolume  types.String
+	DataQuota      types.Int64
+	ReplicaQuota   types.Int64
+}
+
+type DatabaseModel interface {
+	GetCatalog() types.String
+	GetName() types.String
+	GetReplicationNum() types.Int64
+	GetStorageVolume() types.String
+}
+
+// databaseIdentifier renders the optionally catalog-qualified database name.
+func databaseIdentifier(catalog, name string) string {
+	return quoteQualifiedIdentifier(catalog, name)
+}
+
+func (c *Client) CreateDatabase(db DatabaseModel) error {
+	query := "CREATE DATABASE " + databaseIdentifier(db.GetCatalog().ValueString(), db.GetName().ValueString())
+
+	props := make(map[string]string)
+	if v := db.GetReplicationNum(); !v.IsNull() && !v.IsUnknown() {
+		props["replication_num"] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	if v := db.GetStorageVolume(); !v.IsNull() && !v.IsUnknown() {
+		props["storage_volume"] = v.ValueString()
+	}
+	query += quotePropertiesClause(props)
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+func (c *Client) GetDatabase(catalog, name string) (*Database, error) {
+	var dbName, ddl string
+	query := "SHOW CREATE DATABASE " + databaseIdentifier(catalog, name)
+	if err := c.db.QueryRow(query).Scan(&dbName, &ddl); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NotFoundError{Kind: "database", Name: name}
+		}
+		return nil, wrapNotFound(err, "database", name)
+	}
+
+	db := &Database{
+		Catalog:        types.StringNull(),
+		Name:           types.StringValue(name),
+		ReplicationNum: types.Int64Null(),
+		StorageVolume:  types.StringNull(),
+		DataQuota:      types.Int64Null(),
+		ReplicaQuota:   types.Int64Null(),
+	}
+	// The default catalog is left null, as it is when omitted from
+	// configuration.
+	if !isDefaultCatalog(catalog) {
+		db.Catalog = types.StringValue(catalog)
+	}
+
+	props := parseProperties(ddl)
+	if v, ok := props["replication_num"]; ok {
+		db.ReplicationNum = parseInt64Column(v, false)
+	}
+	if v, ok := props["storage_volume"]; ok {
+		db.StorageVolume = parseStringColumn(v)
+	}
+
+	// Quotas only exist for databases of the default catalog.
+	if isDefaultCatalog(catalog) {
+		if err := c.readDatabaseQuotas(db); err != nil {
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// readDatabaseQuotas fills the data and replica quotas of db from
+// SHOW PROC '/dbs'. Quotas left at their defaults are reported as null.
+func (c *Client) readDatabaseQuotas(db *Database) error {
+	rows, err := c.queryRows("SHOW PROC '/dbs'")
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if row.get("dbname") != db.Name.ValueString() {
+			continue
+		}
+
+		if quota, err := parseByteSize(row.get("quota")); err == nil && quota < unlimitedDataQuota {
+			db.DataQuota = types.Int64Value(quota)
+		}
+		if quota, err := strconv.ParseInt(strings.TrimSpace(row.get("replicaquota")), 10, 64); err == nil && quota != defaultReplicaQuota {
+			db.ReplicaQuota = types.Int64Value(quota)
+		}
+	}
+
+	return nil
+}
+
+// SetDatabaseDataQuota sets the data quota of a default catalog database in
+// bytes. A null quota removes the limit.
+func (c *Client) SetDatabaseDataQuota(name string, quota types.Int64) error {
+	bytes := int64(math.MaxInt64)
+	if !quota.IsNull() {
+		bytes = quota.ValueInt64()
+	}
+
+	query := fmt.Sprintf("ALTER DATABASE %s SET DATA QUOTA %d", quoteIdentifier(name), bytes)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetDatabaseReplicaQuota sets the replica quota of a default catalog
+// database. A null quota restores the default.
+func (c *Client) SetDatabaseReplicaQuota(name string, quota types.Int64) error {
+	replicas := defaultReplicaQuota
+	if !quota.IsNull() {
+		replicas = quota.ValueInt64()
+	}
+
+	query := fmt.Sprintf("ALTER DATABASE %s SET REPLICA QUOTA %d", quoteIdentifier(name), replicas)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+func (c *Client) DropDatabase(catalog, name string) error {
+	query := "DROP DATABASE " + databaseIdentifier(catalog, name)
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "database", name)
+}
+
+// isDefaultCatalog reports whether catalog refers to the internal catalog.
+func isDefaultCatalog(catalog string) bool {
+	return catalog == "" || catalog == "default_catalog"
+}
+
+// unlimitedDataQuota is the smallest quota treated as "no limit". StarRocks
+// reports the default of Long.MAX_VALUE bytes as "8388608.000 TB".
+const unlimitedDataQuota int64 = 8388607 << 40
+
+var byteUnits = map[string]float64{
+	"":   1,
+	"B":  1,
+	"K":  1 << 10,
+	"KB": 1 << 10,
+	"M":  1 << 20,
+	"MB": 1 << 20,
+	"G":  1 << 30,
+	"GB": 1 << 30,
+	"T":  1 << 40,
+	"TB": 1 << 40,
+	"P":  1 << 50,
+	"PB": 1 << 50,
+}
+
+// parseByteSize parses sizes such as "1.000 GB" or "1024" into bytes.
+func parseByteSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r == '.')
+	})
+	number, unit := s, ""
+	if end >= 0 {
+		number, unit = s[:end], strings.ToUpper(strings.TrimSpace(s[end:]))
+	}
+
+	multiplier, ok := byteUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("unknown size unit %q", unit)
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	bytes := value * multiplier
+	if bytes >= math.MaxInt64 {
+		return math.MaxInt64, nil
+	}
+	return int64(math.Round(bytes)), nil
+}
+
+// sameByteSize reports whether two sizes are equal within the precision of
+// SHOW PROC output, which rounds to three decimals of the largest unit.
+func sameByteSize(a, b int64) bool {
+	diff := math.Abs(float64(a) - float64(b))
+	return diff <= math.Max(math.Abs(float64(a)), math.Abs(float64(b)))*0.001
+}
@@ -0,0 +1,205 @@
+package starrocks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateDatabase(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE `analytics` PROPERTIES ('replication_num' = '3', 'storage_volume' = 'sv1')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE `hive`.`lake`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreateDatabase(&databaseResourceModel{
+		Name:           types.StringValue("analytics"),
+		ReplicationNum: types.Int64Value(3),
+		StorageVolume:  types.StringValue("sv1"),
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabase failed: %v", err)
+	}
+	err = client.CreateDatabase(&databaseResourceModel{
+		Catalog:       types.StringValue("hive"),
+		Name:          types.StringValue("lake"),
+		StorageVolume: types.StringUnknown(),
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabase failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetDatabase(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE DATABASE `analytics`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Database", "Create Database"}).AddRow(
+			"analytics",
+			"CREATE DATABASE `analytics`\nPROPERTIES (\"replication_num\" = \"3\", \"storage_volume\" = \"builtin_storage_volume\")",
+		),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW PROC '/dbs'")).WillReturnRows(
+		sqlmock.NewRows([]string{"DbId", "DbName", "TableNum", "Quota", "LastConsistencyCheckTime", "ReplicaQuota"}).
+			AddRow("10001", "other", "1", "8388608.000 TB", nil, "1073741824").
+			AddRow("10002", "analytics", "4", "10.000 GB", nil, "5000"),
+	)
+
+	got, err := client.GetDatabase("", "analytics")
+	if err != nil {
+		t.Fatalf("GetDatabase failed: %v", err)
+	}
+
+	if got.ReplicationNum.ValueInt64() != 3 {
+		t.Errorf("ReplicationNum = %v, want 3", got.ReplicationNum)
+	}
+	if got.StorageVolume.ValueString() != "builtin_storage_volume" {
+		t.Errorf("StorageVolume = %v, want builtin_storage_volume", got.StorageVolume)
+	}
+	if got.DataQuota.ValueInt64() != 10<<30 {
+		t.Errorf("DataQuota = %v, want %d", got.DataQuota, int64(10<<30))
+	}
+	if got.ReplicaQuota.ValueInt64() != 5000 {
+		t.Errorf("ReplicaQuota = %v, want 5000", got.ReplicaQuota)
+	}
+	if !got.Catalog.IsNull() {
+		t.Errorf("Catalog = %v, want null", got.Catalog)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetDatabase_DefaultCatalog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE DATABASE `default_catalog`.`analytics`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Database", "Create Database"}).AddRow("analytics", "CREATE DATABASE `analytics`"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW PROC '/dbs'")).WillReturnRows(
+		sqlmock.NewRows([]string{"DbId", "DbName", "TableNum", "Quota", "LastConsistencyCheckTime", "ReplicaQuota"}).
+			AddRow("10002", "analytics", "4", "8388608.000 TB", nil, "1073741824"),
+	)
+
+	got, err := client.GetDatabase("default_catalog", "analytics")
+	if err != nil {
+		t.Fatalf("GetDatabase failed: %v", err)
+	}
+	if !got.Catalog.IsNull() {
+		t.Errorf("Catalog = %v, want null", got.Catalog)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetDatabase_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE DATABASE `missing`")).
+		WillReturnError(&mysql.MySQLError{Number: 1049, Message: "Unknown database 'missing'"})
+
+	if _, err := client.GetDatabase("", "missing"); !IsNotFound(err) {
+		t.Errorf("GetDatabase error = %v, want not found", err)
+	}
+}
+
+func TestSetDatabaseQuotas(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("ALTER DATABASE `analytics` SET DATA QUOTA 1073741824")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER DATABASE `analytics` SET DATA QUOTA 9223372036854775807")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER DATABASE `analytics` SET REPLICA QUOTA 1073741824")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.SetDatabaseDataQuota("analytics", types.Int64Value(1<<30)); err != nil {
+		t.Fatalf("SetDatabaseDataQuota failed: %v", err)
+	}
+	if err := client.SetDatabaseDataQuota("analytics", types.Int64Null()); err != nil {
+		t.Fatalf("SetDatabaseDataQuota failed: %v", err)
+	}
+	if err := client.SetDatabaseReplicaQuota("analytics", types.Int64Null()); err != nil {
+		t.Fatalf("SetDatabaseReplicaQuota failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := map[string]int64{
+		"1024":           1024,
+		"1.000 KB":       1024,
+		"10.000 GB":      10 << 30,
+		"1.5 TB":         3 << 39,
+		"8388608.000 TB": 1<<63 - 1,
+	}
+
+	for input, want := range tests {
+		got, err := parseByteSize(input)
+		if err != nil {
+			t.Errorf("parseByteSize(%q) failed: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseByteSize(%q) = %d, want %d", input, got, want)
+		}
+	}
+
+	if _, err := parseByteSize("10 XB"); err == nil {
+		t.Error("parseByteSize should reject unknown units")
+	}
+}
+
+func TestSameByteSize(t *testing.T) {
+	if !sameByteSize(1234567890, 1234803097) {
+		t.Error("sizes within display rounding should be equal")
+	}
+	if sameByteSize(1<<30, 2<<30) {
+		t.Error("different sizes should not be equal")
+	}
+}
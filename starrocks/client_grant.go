@@ -0,0 +1,471 @@
+package starrocks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Object types that privileges can be granted on.
+const (
+	grantObjectSystem           = "SYSTEM"
+	grantObjectCatalog          = "CATALOG"
+	grantObjectDatabase         = "DATABASE"
+	grantObjectTable            = "TABLE"
+	grantObjectView             = "VIEW"
+	grantObjectMaterializedView = "MATERIALIZED VIEW"
+	grantObjectFunction         = "FUNCTION"
+	grantObjectResourceGroup    = "RESOURCE GROUP"
+	grantObjectStorageVolume    = "STORAGE VOLUME"
+	grantObjectWarehouse        = "WAREHOUSE"
+)
+
+// grantAll stands for every object of a type, or every database, in a
+// GrantObject.
+const grantAll = "*"
+
+// grantPrivileges lists the privileges of each object type, not counting
+// ALL, which expands to all of them.
+var grantPrivileges = map[string][]string{
+	grantObjectSystem: {
+		"GRANT", "NODE", "CREATE RESOURCE GROUP", "CREATE RESOURCE", "CREATE EXTERNAL CATALOG",
+		"PLUGIN", "REPOSITORY", "BLACKLIST", "FILE", "OPERATE", "CREATE GLOBAL FUNCTION",
+		"CREATE STORAGE VOLUME", "SECURITY", "CREATE WAREHOUSE",
+	},
+	grantObjectCatalog:          {"USAGE", "CREATE DATABASE", "DROP", "ALTER"},
+	grantObjectDatabase:         {"ALTER", "DROP", "CREATE TABLE", "CREATE VIEW", "CREATE FUNCTION", "CREATE MATERIALIZED VIEW", "CREATE PIPE"},
+	grantObjectTable:            {"ALTER", "DROP", "SELECT", "INSERT", "EXPORT", "UPDATE", "DELETE"},
+	grantObjectView:             {"ALTER", "DROP", "SELECT"},
+	grantObjectMaterializedView: {"ALTER", "DROP", "SELECT", "REFRESH"},
+	grantObjectFunction:         {"USAGE", "DROP"},
+	grantObjectResourceGroup:    {"ALTER", "DROP"},
+	grantObjectStorageVolume:    {"ALTER", "DROP", "USAGE"},
+	grantObjectWarehouse:        {"USAGE", "ALTER", "DROP"},
+}
+
+// grantObjectPlurals are the plural keywords used by ON ALL <objects>.
+var grantObjectPlurals = map[string]string{
+	grantObjectCatalog:          "CATALOGS",
+	grantObjectDatabase:         "DATABASES",
+	grantObjectTable:            "TABLES",
+	grantObjectView:             "VIEWS",
+	grantObjectMaterializedView: "MATERIALIZED VIEWS",
+	grantObjectFunction:         "FUNCTIONS",
+	grantObjectResourceGroup:    "RESOURCE GROUPS",
+	grantObjectStorageVolume:    "STORAGE VOLUMES",
+	grantObjectWarehouse:        "WAREHOUSES",
+}
+
+// grantObjectTypes lists the object types with multi-word types first, so
+// that prefix matching picks the longest keyword.
+var grantObjectTypes = []string{
+	grantObjectMaterializedView,
+	grantObjectResourceGroup,
+	grantObjectStorageVolume,
+	grantObjectSystem,
+	grantObjectCatalog,
+	grantObjectDatabase,
+	grantObjectTable,
+	grantObjectView,
+	grantObjectFunction,
+	grantObjectWarehouse,
+}
+
+// privilegePattern matches a privilege keyword such as "CREATE TABLE".
+var privilegePattern = regexp.MustCompile(`^[A-Z]+( [A-Z]+)*$`)
+
+// functionArgsPattern matches the argument list of a function signature,
+// e.g. "(INT, DECIMAL(10, 2))".
+var functionArgsPattern = regexp.MustCompile(`^\([A-Za-z0-9_, ()]*\)$`)
+
+// Grantee is the role or user that privileges are granted to.
+type Grantee struct {
+	Role string
+	User string
+	Host string
+}
+
+func (g Grantee) String() string {
+	if g.Role != "" {
+		return "ROLE " + quoteIdentifier(g.Role)
+	}
+	return "USER " + userIdentity(g.User, g.Host)
+}
+
+func (g Grantee) name() string {
+	if g.Role != "" {
+		return g.Role
+	}
+	return g.User
+}
+
+// GrantObject identifies what privileges apply to. Database is set for
+// databases and the objects they contain; Name for everything else but
+// SYSTEM. Either may be grantAll. Function names include their argument
+// list, e.g. "my_udf(INT)".
+type GrantObject struct {
+	Type     string
+	Database string
+	Name     string
+}
+
+// inDatabase reports whether objects of the type live in a database.
+func (o GrantObject) inDatabase() bool {
+	switch o.Type {
+	case grantObjectTable, grantObjectView, grantObjectMaterializedView, grantObjectFunction:
+		return true
+	}
+	return false
+}
+
+// String renders the object as it follows ON in a GRANT statement.
+func (o GrantObject) String() string {
+	switch {
+	case o.Type == grantObjectSystem:
+		return grantObjectSystem
+	case o.Type == grantObjectDatabase:
+		if o.Database == grantAll {
+			return "ALL DATABASES"
+		}
+		return "DATABASE " + quoteIdentifier(o.Database)
+	case o.inDatabase():
+		if o.Name == grantAll {
+			if o.Database == grantAll {
+				return "ALL " + grantObjectPlurals[o.Type] + " IN ALL DATABASES"
+			}
+			return "ALL " + grantObjectPlurals[o.Type] + " IN DATABASE " + quoteIdentifier(o.Database)
+		}
+		name, args := splitFunctionSignature(o.Name)
+		return o.Type + " " + quoteQualifiedIdentifier(o.Database, name) + args
+	default:
+		if o.Name == grantAll {
+			return "ALL " + grantObjectPlurals[o.Type]
+		}
+		return o.Type + " " + quoteIdentifier(o.Name)
+	}
+}
+
+// key returns a canonical form of the object for comparisons.
+func (o GrantObject) key() string {
+	name, args := splitFunctionSignature(o.Name)
+	args = strings.ToUpper(strings.Join(strings.Fields(args), ""))
+	return o.Type + "|" + o.Database + "|" + name + args
+}
+
+// validate checks that the object names required by its type are set.
+func (o GrantObject) validate() error {
+	if _, ok := grantPrivileges[o.Type]; !ok {
+		return fmt.Errorf("unsupported object type %q", o.Type)
+	}
+
+	switch {
+	case o.Type == grantObjectSystem:
+		if o.Database != "" || o.Name != "" {
+			return fmt.Errorf("%s grants do not take a database or object name", o.Type)
+		}
+	case o.Type == grantObjectDatabase:
+		if o.Database == "" {
+			return fmt.Errorf("%s grants require a database", o.Type)
+		}
+		if o.Name != "" {
+			return fmt.Errorf("%s grants do not take an object name", o.Type)
+		}
+	case o.inDatabase():
+		if o.Database == "" || o.Name == "" {
+			return fmt.Errorf("%s grants require a database and an object name", o.Type)
+		}
+		if o.Database == grantAll && o.Name != grantAll {
+			return fmt.Errorf("%s grants on all databases require object name %q", o.Type, grantAll)
+		}
+		if o.Type == grantObjectFunction && o.Name != grantAll {
+			if _, args := splitFunctionSignature(o.Name); !functionArgsPattern.MatchString(args) {
+				return fmt.Errorf("function %q must include its argument types, e.g. my_udf(INT)", o.Name)
+			}
+		}
+	default:
+		if o.Name == "" {
+			return fmt.Errorf("%s grants require an object name", o.Type)
+		}
+		if o.Database != "" {
+			return fmt.Errorf("%s grants do not take a database", o.Type)
+		}
+	}
+	return nil
+}
+
+// splitFunctionSignature splits "fn(INT)" into "fn" and "(INT)". Names
+// without an argument list are returned unchanged.
+func splitFunctionSignature(name string) (string, string) {
+	if i := strings.Index(name, "("); i >= 0 {
+		return name[:i], name[i:]
+	}
+	return name, ""
+}
+
+// Grant is a set of privileges on one object, as listed by SHOW GRANTS.
+type Grant struct {
+	Catalog     string
+	Object      GrantObject
+	Privileges  []string
+	GrantOption bool
+}
+
+func (c *Client) GrantPrivileges(grantee Grantee, obj GrantObject, privileges []string, grantOption bool) error {
+	if len(privileges) == 0 {
+		return nil
+	}
+	privs, err := privilegeList(privileges)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("GRANT %s ON %s TO %s", privs, obj, grantee)
+	if grantOption {
+		query += " WITH GRANT OPTION"
+	}
+
+	_, err = c.db.Exec(query)
+	return err
+}
+
+func (c *Client) RevokePrivileges(grantee Grantee, obj GrantObject, privileges []string) error {
+	if len(privileges) == 0 {
+		return nil
+	}
+	privs, err := privilegeList(privileges)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("REVOKE %s ON %s FROM %s", privs, obj, grantee)
+	_, err = c.db.Exec(query)
+	return wrapUserNotFound(err, "grant", grantee.name())
+}
+
+// privilegeList renders privileges for a GRANT or REVOKE statement. They are
+// keywords and cannot be quoted, so anything but plain words is rejected.
+func privilegeList(privileges []string) (string, error) {
+	normalized := normalizePrivileges(privileges)
+	for _, p := range normalized {
+		if !privilegePattern.MatchString(p) {
+			return "", fmt.Errorf("invalid privilege %q", p)
+		}
+	}
+	return strings.Join(normalized, ", "), nil
+}
+
+// normalizePrivileges upper-cases privileges, collapses their whitespace and
+// sorts them.
+func normalizePrivileges(privileges []string) []string {
+	normalized := make([]string, 0, len(privileges))
+	for _, p := range privileges {
+		if p = strings.ToUpper(strings.Join(strings.Fields(p), " ")); p != "" {
+			normalized = append(normalized, p)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// expandPrivileges replaces ALL with the privileges of the object type, so
+// that ALL compares equal to the expanded list SHOW GRANTS may print.
+func expandPrivileges(objectType string, privileges []string) []string {
+	seen := make(map[string]bool)
+	for _, p := range normalizePrivileges(privileges) {
+		if p == "ALL" {
+			for _, q := range grantPrivileges[objectType] {
+				seen[q] = true
+			}
+			continue
+		}
+		seen[p] = true
+	}
+
+	expanded := make([]string, 0, len(seen))
+	for p := range seen {
+		expanded = append(expanded, p)
+	}
+	sort.Strings(expanded)
+	return expanded
+}
+
+// ListGrants returns the privileges granted directly to grantee. Roles
+// granted to it are not included.
+func (c *Client) ListGrants(grantee Grantee) ([]Grant, error) {
+	var query string
+	if grantee.Role != "" {
+		query = "SHOW GRANTS FOR ROLE " + quoteIdentifier(grantee.Role)
+	} else {
+		query = "SHOW GRANTS FOR " + userIdentity(grantee.User, grantee.Host)
+	}
+
+	rows, err := c.queryRows(query)
+	if err != nil {
+		return nil, wrapUserNotFound(err, "grantee", grantee.name())
+	}
+
+	var grants []Grant
+	for _, row := range rows {
+		// A row may hold several statements separated by newlines.
+		for _, stmt := range strings.Split(row.get("grants"), "\n") {
+			if g, ok := parseObjectGrant(stmt); ok {
+				g.Catalog = row.get("catalog")
+				grants = append(grants, g)
+			}
+		}
+	}
+	return grants, nil
+}
+
+// GetGrantedPrivileges returns the privileges grantee holds on obj, among
+// the grants with or without grant option as requested.
+func (c *Client) GetGrantedPrivileges(grantee Grantee, obj GrantObject, grantOption bool) ([]string, error) {
+	grants, err := c.ListGrants(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only objects of the default catalog are managed.
+	checkCatalog := obj.Type == grantObjectDatabase || obj.inDatabase()
+
+	var privileges []string
+	for _, g := range grants {
+		if g.Object.key() != obj.key() || g.GrantOption != grantOption {
+			continue
+		}
+		if checkCatalog && !isDefaultCatalog(g.Catalog) {
+			continue
+		}
+		privileges = append(privileges, g.Privileges...)
+	}
+	return normalizePrivileges(dedupeStrings(privileges)), nil
+}
+
+// parseObjectGrant parses a SHOW GRANTS statement such as
+// GRANT SELECT, INSERT ON TABLE db.tbl TO ROLE 'r' WITH GRANT OPTION.
+// Role grants and unrecognized objects are reported as not ok.
+func parseObjectGrant(stmt string) (Grant, bool) {
+	stmt = strings.TrimSuffix(strings.TrimSpace(stmt), ";")
+	upper := strings.ToUpper(stmt)
+	if !strings.HasPrefix(upper, "GRANT ") {
+		return Grant{}, false
+	}
+
+	on := strings.Index(upper, " ON ")
+	to := strings.LastIndex(upper, " TO ")
+	if on < 0 || to < on {
+		return Grant{}, false
+	}
+
+	obj, ok := parseGrantObject(strings.TrimSpace(stmt[on+len(" ON ") : to]))
+	if !ok {
+		return Grant{}, false
+	}
+
+	return Grant{
+		Object:      obj,
+		Privileges:  normalizePrivileges(strings.Split(stmt[len("GRANT "):on], ",")),
+		GrantOption: strings.HasSuffix(upper, " WITH GRANT OPTION"),
+	}, true
+}
+
+// parseGrantObject parses the object of a SHOW GRANTS statement, e.g.
+// "TABLE db.tbl", "ALL TABLES IN DATABASE db" or "SYSTEM".
+func parseGrantObject(s string) (GrantObject, bool) {
+	upper := strings.ToUpper(s)
+	if upper == grantObjectSystem {
+		return GrantObject{Type: grantObjectSystem}, true
+	}
+
+	if strings.HasPrefix(upper, "ALL ") {
+		rest, upperRest := s[len("ALL "):], upper[len("ALL "):]
+		for _, objType := range grantObjectTypes {
+			plural, ok := grantObjectPlurals[objType]
+			if !ok || !strings.HasPrefix(upperRest, plural) {
+				continue
+			}
+			tail := strings.TrimSpace(rest[len(plural):])
+			upperTail := strings.ToUpper(tail)
+
+			obj := GrantObject{Type: objType, Name: grantAll}
+			switch {
+			case objType == grantObjectDatabase && tail == "":
+				return GrantObject{Type: objType, Database: grantAll}, true
+			case !obj.inDatabase() && tail == "":
+				return obj, true
+			case obj.inDatabase() && upperTail == "IN ALL DATABASES":
+				obj.Database = grantAll
+				return obj, true
+			case obj.inDatabase() && strings.HasPrefix(upperTail, "IN DATABASE "):
+				parts := splitQualifiedName(tail[len("IN DATABASE "):])
+				obj.Database = parts[len(parts)-1]
+				return obj, true
+			}
+		}
+		return GrantObject{}, false
+	}
+
+	for _, objType := range grantObjectTypes {
+		if !strings.HasPrefix(upper, objType+" ") {
+			continue
+		}
+		name := strings.TrimSpace(s[len(objType)+1:])
+		obj := GrantObject{Type: objType}
+
+		switch {
+		case objType == grantObjectDatabase:
+			parts := splitQualifiedName(name)
+			obj.Database = parts[len(parts)-1]
+		case obj.inDatabase():
+			name, args := splitFunctionSignature(name)
+			parts := splitQualifiedName(name)
+			if len(parts) < 2 {
+				return GrantObject{}, false
+			}
+			obj.Database = parts[len(parts)-2]
+			obj.Name = parts[len(parts)-1] + args
+		default:
+			obj.Name = unquoteName(name)
+		}
+		return obj, true
+	}
+
+	return GrantObject{}, false
+}
+
+// splitQualifiedName splits a dotted name such as `db`.`tbl` into its
+// unquoted parts, ignoring dots inside quotes.
+func splitQualifiedName(s string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '.':
+			parts = append(parts, unquoteName(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, unquoteName(s[start:]))
+}
+
+// dedupeStrings returns values without duplicates, keeping the first
+// occurrence of each.
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
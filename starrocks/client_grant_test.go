@@ -0,0 +1,226 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestGrantObjectString(t *testing.T) {
+	tests := []struct {
+		obj  GrantObject
+		want string
+	}{
+		{GrantObject{Type: grantObjectSystem}, "SYSTEM"},
+		{GrantObject{Type: grantObjectDatabase, Database: "analytics"}, "DATABASE `analytics`"},
+		{GrantObject{Type: grantObjectDatabase, Database: "*"}, "ALL DATABASES"},
+		{GrantObject{Type: grantObjectTable, Database: "analytics", Name: "events"}, "TABLE `analytics`.`events`"},
+		{GrantObject{Type: grantObjectTable, Database: "analytics", Name: "*"}, "ALL TABLES IN DATABASE `analytics`"},
+		{GrantObject{Type: grantObjectMaterializedView, Database: "*", Name: "*"}, "ALL MATERIALIZED VIEWS IN ALL DATABASES"},
+		{GrantObject{Type: grantObjectFunction, Database: "udf", Name: "my_fn(INT, STRING)"}, "FUNCTION `udf`.`my_fn`(INT, STRING)"},
+		{GrantObject{Type: grantObjectResourceGroup, Name: "rg1"}, "RESOURCE GROUP `rg1`"},
+		{GrantObject{Type: grantObjectWarehouse, Name: "*"}, "ALL WAREHOUSES"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.obj.String(); got != tt.want {
+			t.Errorf("%+v.String() = %s, want %s", tt.obj, got, tt.want)
+		}
+	}
+}
+
+func TestGrantObjectValidate(t *testing.T) {
+	valid := []GrantObject{
+		{Type: grantObjectSystem},
+		{Type: grantObjectDatabase, Database: "db"},
+		{Type: grantObjectTable, Database: "db", Name: "*"},
+		{Type: grantObjectFunction, Database: "db", Name: "fn(DECIMAL(10, 2))"},
+		{Type: grantObjectStorageVolume, Name: "sv"},
+	}
+	for _, obj := range valid {
+		if err := obj.validate(); err != nil {
+			t.Errorf("%+v.validate() = %v, want nil", obj, err)
+		}
+	}
+
+	invalid := []GrantObject{
+		{Type: "INDEX", Name: "x"},
+		{Type: grantObjectSystem, Name: "x"},
+		{Type: grantObjectDatabase},
+		{Type: grantObjectDatabase, Database: "db", Name: "t"},
+		{Type: grantObjectTable, Database: "db"},
+		{Type: grantObjectTable, Database: "*", Name: "t"},
+		{Type: grantObjectFunction, Database: "db", Name: "fn"},
+		{Type: grantObjectFunction, Database: "db", Name: "fn(INT); DROP"},
+		{Type: grantObjectCatalog, Database: "db", Name: "c"},
+	}
+	for _, obj := range invalid {
+		if err := obj.validate(); err == nil {
+			t.Errorf("%+v.validate() should fail", obj)
+		}
+	}
+}
+
+func TestParseObjectGrant(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want Grant
+	}{
+		{
+			"GRANT SELECT, INSERT ON TABLE analytics.events TO ROLE 'analyst'",
+			Grant{Object: GrantObject{Type: grantObjectTable, Database: "analytics", Name: "events"}, Privileges: []string{"INSERT", "SELECT"}},
+		},
+		{
+			"GRANT SELECT ON ALL TABLES IN DATABASE `analytics` TO USER 'jack'@'%' WITH GRANT OPTION",
+			Grant{Object: GrantObject{Type: grantObjectTable, Database: "analytics", Name: "*"}, Privileges: []string{"SELECT"}, GrantOption: true},
+		},
+		{
+			"GRANT USAGE ON ALL CATALOGS TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectCatalog, Name: "*"}, Privileges: []string{"USAGE"}},
+		},
+		{
+			"GRANT CREATE TABLE, ALTER ON DATABASE default_catalog.analytics TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectDatabase, Database: "analytics"}, Privileges: []string{"ALTER", "CREATE TABLE"}},
+		},
+		{
+			"GRANT NODE, GRANT ON SYSTEM TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectSystem}, Privileges: []string{"GRANT", "NODE"}},
+		},
+		{
+			"GRANT ALTER ON MATERIALIZED VIEW db.mv1 TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectMaterializedView, Database: "db", Name: "mv1"}, Privileges: []string{"ALTER"}},
+		},
+		{
+			"GRANT USAGE ON FUNCTION db.my_fn(INT,STRING) TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectFunction, Database: "db", Name: "my_fn(INT,STRING)"}, Privileges: []string{"USAGE"}},
+		},
+		{
+			"GRANT ALTER, DROP ON RESOURCE GROUP rg1 TO ROLE 'r'",
+			Grant{Object: GrantObject{Type: grantObjectResourceGroup, Name: "rg1"}, Privileges: []string{"ALTER", "DROP"}},
+		},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseObjectGrant(tt.stmt)
+		if !ok {
+			t.Errorf("parseObjectGrant(%q) not ok", tt.stmt)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseObjectGrant(%q) = %+v, want %+v", tt.stmt, got, tt.want)
+		}
+	}
+
+	for _, stmt := range []string{"GRANT 'reader' TO ROLE 'analyst'", "GRANT USAGE ON GLOBAL FUNCTION fn(INT) TO ROLE r"} {
+		if _, ok := parseObjectGrant(stmt); ok {
+			t.Errorf("parseObjectGrant(%q) should not be ok", stmt)
+		}
+	}
+}
+
+func TestGrantObjectKey(t *testing.T) {
+	a := GrantObject{Type: grantObjectFunction, Database: "db", Name: "fn(int, string)"}
+	b := GrantObject{Type: grantObjectFunction, Database: "db", Name: "fn(INT,STRING)"}
+	if a.key() != b.key() {
+		t.Errorf("function keys differ: %s != %s", a.key(), b.key())
+	}
+}
+
+func TestExpandPrivileges(t *testing.T) {
+	got := expandPrivileges(grantObjectView, []string{"all", "select"})
+	if want := []string{"ALTER", "DROP", "SELECT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expandPrivileges() = %v, want %v", got, want)
+	}
+}
+
+func TestGrantPrivileges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	table := GrantObject{Type: grantObjectTable, Database: "analytics", Name: "events"}
+
+	mock.ExpectExec(regexp.QuoteMeta("GRANT INSERT, SELECT ON TABLE `analytics`.`events` TO ROLE `analyst` WITH GRANT OPTION")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("REVOKE CREATE TABLE ON DATABASE `analytics` FROM USER 'jack'@'%'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.GrantPrivileges(Grantee{Role: "analyst"}, table, []string{"select", "insert"}, true); err != nil {
+		t.Fatalf("GrantPrivileges failed: %v", err)
+	}
+	database := GrantObject{Type: grantObjectDatabase, Database: "analytics"}
+	if err := client.RevokePrivileges(Grantee{User: "jack", Host: "%"}, database, []string{"create  table"}); err != nil {
+		t.Fatalf("RevokePrivileges failed: %v", err)
+	}
+	if err := client.GrantPrivileges(Grantee{Role: "analyst"}, table, []string{"SELECT; DROP DATABASE x"}, false); err == nil {
+		t.Error("GrantPrivileges should reject malformed privileges")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetGrantedPrivileges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GRANTS FOR ROLE `analyst`")).WillReturnRows(
+		sqlmock.NewRows([]string{"RoleName", "Catalog", "Grants"}).
+			AddRow("analyst", nil, "GRANT 'reader' TO ROLE 'analyst'").
+			AddRow("analyst", "default_catalog", "GRANT SELECT ON TABLE analytics.events TO ROLE 'analyst'").
+			AddRow("analyst", "default_catalog", "GRANT INSERT ON TABLE analytics.events TO ROLE 'analyst'\nGRANT DROP ON TABLE analytics.other TO ROLE 'analyst'").
+			AddRow("analyst", "default_catalog", "GRANT ALTER ON TABLE analytics.events TO ROLE 'analyst' WITH GRANT OPTION").
+			AddRow("analyst", "hive", "GRANT DELETE ON TABLE analytics.events TO ROLE 'analyst'"),
+	)
+
+	table := GrantObject{Type: grantObjectTable, Database: "analytics", Name: "events"}
+	got, err := client.GetGrantedPrivileges(Grantee{Role: "analyst"}, table, false)
+	if err != nil {
+		t.Fatalf("GetGrantedPrivileges failed: %v", err)
+	}
+	if want := []string{"INSERT", "SELECT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetGrantedPrivileges() = %v, want %v", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestParseGrantImportID(t *testing.T) {
+	state, err := parseGrantImportID("role|analyst|table|analytics.*")
+	if err != nil {
+		t.Fatalf("parseGrantImportID failed: %v", err)
+	}
+	if state.Role.ValueString() != "analyst" || state.ObjectType.ValueString() != grantObjectTable ||
+		state.Database.ValueString() != "analytics" || state.ObjectName.ValueString() != "*" {
+		t.Errorf("parseGrantImportID() = %+v", state)
+	}
+
+	// The user identity is normalized to the quoted form.
+	state, err = parseGrantImportID("user|jack@%|SYSTEM")
+	if err != nil {
+		t.Fatalf("parseGrantImportID failed: %v", err)
+	}
+	if state.User.ValueString() != "'jack'@'%'" || !state.Database.IsNull() || !state.ObjectName.IsNull() {
+		t.Errorf("parseGrantImportID() = %+v", state)
+	}
+
+	for _, id := range []string{"analyst|TABLE|db.t", "group|x|SYSTEM", "role|r|TABLE|db", "role|r|DATABASE"} {
+		if _, err := parseGrantImportID(id); err == nil {
+			t.Errorf("parseGrantImportID(%q) should fail", id)
+		}
+	}
+}
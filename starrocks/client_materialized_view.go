@@ -0,0 +1,287 @@
+package starrocks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Refresh types and moments of asynchronous materialized views.
+const (
+	refreshAsync     = "ASYNC"
+	refreshManual    = "MANUAL"
+	refreshImmediate = "IMMEDIATE"
+	refreshDeferred  = "DEFERRED"
+)
+
+// refreshIntervalPattern matches the interval of EVERY(INTERVAL ...), e.g.
+// "1 DAY".
+var refreshIntervalPattern = regexp.MustCompile(`(?i)^\d+ (SECOND|MINUTE|HOUR|DAY|WEEK|MONTH|YEAR)$`)
+
+type MaterializedViewRefresh struct {
+	Type     types.String
+	Moment   types.String
+	Start    types.String
+	Interval types.String
+}
+
+type MaterializedView struct {
+	Database     types.String
+	Name         types.String
+	Query        types.String
+	Comment      types.String
+	Refresh      *MaterializedViewRefresh
+	PartitionBy  types.String
+	Distribution *TableDistribution
+	OrderBy      types.List
+	Properties   types.Map
+	Active       types.Bool
+}
+
+var materializedViewRefreshAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"moment":   types.StringType,
+	"start":    types.StringType,
+	"interval": types.StringType,
+}
+
+func (c *Client) CreateMaterializedView(mv *MaterializedView) error {
+	var b strings.Builder
+	b.WriteString("CREATE MATERIALIZED VIEW ")
+	b.WriteString(tableIdentifier(mv.Database.ValueString(), mv.Name.ValueString()))
+	if !mv.Comment.IsNull() && !mv.Comment.IsUnknown() {
+		b.WriteString("\nCOMMENT " + quoteString(mv.Comment.ValueString()))
+	}
+	if d := mv.Distribution; d != nil {
+		b.WriteString("\n" + distributionClause(d))
+	}
+	if r := mv.Refresh; r != nil {
+		b.WriteString("\n" + refreshClause(r, true))
+	}
+	if p := mv.PartitionBy; !p.IsNull() && !p.IsUnknown() {
+		b.WriteString("\nPARTITION BY " + p.ValueString())
+	}
+	if order := listValues(mv.OrderBy); len(order) > 0 {
+		b.WriteString("\nORDER BY (" + quoteIdentifiers(order) + ")")
+	}
+	b.WriteString(quotePropertiesClause(mapValues(mv.Properties)))
+	b.WriteString("\nAS " + viewQuery(mv.Query.ValueString()))
+
+	_, err := c.db.Exec(b.String())
+	return err
+}
+
+// refreshClause renders the REFRESH clause of a materialized view. The
+// refresh moment only applies to CREATE MATERIALIZED VIEW.
+func refreshClause(r *MaterializedViewRefresh, withMoment bool) string {
+	clause := "REFRESH "
+	if m := r.Moment; withMoment && !m.IsNull() && !m.IsUnknown() {
+		clause += strings.ToUpper(m.ValueString()) + " "
+	}
+	clause += strings.ToUpper(r.Type.ValueString())
+	if s := r.Start; !s.IsNull() && !s.IsUnknown() {
+		clause += " START(" + quoteString(s.ValueString()) + ")"
+	}
+	if i := r.Interval; !i.IsNull() && !i.IsUnknown() {
+		clause += " EVERY(INTERVAL " + i.ValueString() + ")"
+	}
+	return clause
+}
+
+// SetMaterializedViewRefresh changes the refresh strategy of a materialized
+// view.
+func (c *Client) SetMaterializedViewRefresh(database, name string, r *MaterializedViewRefresh) error {
+	query := fmt.Sprintf("ALTER MATERIALIZED VIEW %s %s", tableIdentifier(database, name), refreshClause(r, false))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "materialized view", name)
+}
+
+// SetMaterializedViewProperties changes materialized view properties, one
+// statement per property.
+func (c *Client) SetMaterializedViewProperties(database, name string, props map[string]string) error {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		query := fmt.Sprintf("ALTER MATERIALIZED VIEW %s SET (%s)", tableIdentifier(database, name), quoteProperty(k, props[k]))
+		if _, err := c.db.Exec(query); err != nil {
+			return wrapNotFound(err, "materialized view", name)
+		}
+	}
+	return nil
+}
+
+// SetMaterializedViewActive activates or deactivates a materialized view.
+func (c *Client) SetMaterializedViewActive(database, name string, active bool) error {
+	state := "ACTIVE"
+	if !active {
+		state = "INACTIVE"
+	}
+	query := fmt.Sprintf("ALTER MATERIALIZED VIEW %s %s", tableIdentifier(database, name), state)
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "materialized view", name)
+}
+
+func (c *Client) GetMaterializedView(database, name string) (*MaterializedView, error) {
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SELECT REFRESH_TYPE, IS_ACTIVE, MATERIALIZED_VIEW_DEFINITION FROM information_schema.materialized_views "+
+			"WHERE TABLE_SCHEMA = %s AND TABLE_NAME = %s",
+		quoteString(database), quoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "materialized view", Name: name}
+	}
+
+	mv := parseMaterializedView(rows[0].get("materialized_view_definition"))
+	mv.Database = types.StringValue(database)
+	mv.Name = types.StringValue(name)
+	mv.Active = types.BoolValue(strings.EqualFold(rows[0].get("is_active"), "true"))
+	if mv.Refresh == nil {
+		if refreshType := strings.ToUpper(rows[0].get("refresh_type")); refreshType != "" {
+			mv.Refresh = &MaterializedViewRefresh{
+				Type:     types.StringValue(refreshType),
+				Moment:   types.StringNull(),
+				Start:    types.StringNull(),
+				Interval: types.StringNull(),
+			}
+		}
+	}
+
+	return mv, nil
+}
+
+var (
+	mvQueryPattern       = regexp.MustCompile(`(?is)\bAS\s+(SELECT|WITH)\b`)
+	mvCommentPattern     = regexp.MustCompile(`(?im)^COMMENT\s+`)
+	mvPartitionByPattern = regexp.MustCompile(`(?im)^PARTITION BY\s+(.+?)\s*$`)
+	mvRefreshPattern     = regexp.MustCompile(
+		`(?i)\bREFRESH\s+(?:(DEFERRED|IMMEDIATE)\s+)?(ASYNC|MANUAL|INCREMENTAL)` +
+			`(?:\s+START\s*\(\s*["']([^"']*)["']\s*\))?` +
+			`(?:\s+EVERY\s*\(\s*INTERVAL\s+(\d+\s+\w+)\s*\))?`,
+	)
+)
+
+// parseMaterializedView extracts the clauses of the CREATE MATERIALIZED VIEW
+// statement reported in information_schema.materialized_views.
+func parseMaterializedView(ddl string) *MaterializedView {
+	mv := &MaterializedView{
+		Query:       types.StringNull(),
+		Comment:     types.StringNull(),
+		PartitionBy: types.StringNull(),
+		OrderBy:     types.ListNull(types.StringType),
+		Properties:  types.MapNull(types.StringType),
+	}
+
+	// Clauses precede the query; the query itself is not searched.
+	head := ddl
+	if loc := mvQueryPattern.FindStringSubmatchIndex(ddl); loc != nil {
+		head = ddl[:loc[0]]
+		mv.Query = types.StringValue(strings.TrimSpace(ddl[loc[2]:]))
+	}
+
+	if loc := mvCommentPattern.FindStringIndex(head); loc != nil {
+		if comment, _, ok := nextStringLiteral(head[loc[1]:]); ok && comment != "" {
+			mv.Comment = types.StringValue(comment)
+		}
+	}
+
+	if m := mvRefreshPattern.FindStringSubmatch(head); m != nil {
+		mv.Refresh = &MaterializedViewRefresh{
+			Type:     types.StringValue(strings.ToUpper(m[2])),
+			Moment:   types.StringNull(),
+			Start:    types.StringNull(),
+			Interval: types.StringNull(),
+		}
+		if m[1] != "" {
+			mv.Refresh.Moment = types.StringValue(strings.ToUpper(m[1]))
+		}
+		if m[3] != "" {
+			mv.Refresh.Start = types.StringValue(m[3])
+		}
+		if m[4] != "" {
+			mv.Refresh.Interval = types.StringValue(strings.ToUpper(strings.Join(strings.Fields(m[4]), " ")))
+		}
+	}
+
+	if m := mvPartitionByPattern.FindStringSubmatch(head); m != nil {
+		mv.PartitionBy = types.StringValue(m[1])
+	}
+
+	if m := tableDistributionPattern.FindStringSubmatch(head); m != nil {
+		mv.Distribution = &TableDistribution{
+			Type:    types.StringValue(distributionHash),
+			Columns: stringList(unquoteNames(m[1])),
+			Buckets: types.Int64Null(),
+		}
+		if m[2] != "" {
+			mv.Distribution.Type = types.StringValue(distributionRandom)
+			mv.Distribution.Columns = types.ListNull(types.StringType)
+		}
+		if m[3] != "" {
+			mv.Distribution.Buckets = parseInt64Column(m[3], true)
+		}
+	}
+
+	if m := tableOrderByPattern.FindStringSubmatch(head); m != nil {
+		mv.OrderBy = stringList(unquoteNames(m[1]))
+	}
+
+	mv.Properties = stringMap(parseProperties(head))
+
+	return mv
+}
+
+// sameExpression reports whether two SQL expressions differ only in
+// whitespace and identifier quoting, e.g. date_trunc('day', `dt`) and
+// date_trunc('day',dt).
+func sameExpression(a, b string) bool {
+	return normalizeQuery(strings.ReplaceAll(a, "`", "")) == normalizeQuery(strings.ReplaceAll(b, "`", ""))
+}
+
+func (c *Client) DropMaterializedView(database, name string) error {
+	_, err := c.db.Exec("DROP MATERIALIZED VIEW " + tableIdentifier(database, name))
+	return wrapNotFound(err, "materialized view", name)
+}
+
+func refreshFromObject(obj types.Object) *MaterializedViewRefresh {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	str := func(name string) types.String {
+		if v, ok := attrs[name].(types.String); ok {
+			return v
+		}
+		return types.StringNull()
+	}
+	return &MaterializedViewRefresh{
+		Type:     str("type"),
+		Moment:   str("moment"),
+		Start:    str("start"),
+		Interval: str("interval"),
+	}
+}
+
+func refreshToObject(r *MaterializedViewRefresh) types.Object {
+	if r == nil {
+		return types.ObjectNull(materializedViewRefreshAttrTypes)
+	}
+
+	return types.ObjectValueMust(materializedViewRefreshAttrTypes, map[string]attr.Value{
+		"type":     r.Type,
+		"moment":   r.Moment,
+		"start":    r.Start,
+		"interval": r.Interval,
+	})
+}
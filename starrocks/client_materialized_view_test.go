@@ -0,0 +1,176 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateMaterializedView(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE MATERIALIZED VIEW `analytics`.`daily_pv`\n" +
+		"COMMENT 'page views'\n" +
+		"DISTRIBUTED BY HASH(`dt`) BUCKETS 4\n" +
+		"REFRESH DEFERRED ASYNC START('2025-01-01 00:00:00') EVERY(INTERVAL 1 DAY)\n" +
+		"PARTITION BY date_trunc('day', dt) PROPERTIES ('partition_refresh_number' = '3', 'resource_group' = 'rg_mv')\n" +
+		"AS SELECT dt, count(*) AS pv FROM events GROUP BY dt")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER MATERIALIZED VIEW `analytics`.`daily_pv` REFRESH MANUAL")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER MATERIALIZED VIEW `analytics`.`daily_pv` SET ('query_rewrite_consistency' = 'LOOSE')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER MATERIALIZED VIEW `analytics`.`daily_pv` SET ('resource_group' = 'rg_default')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER MATERIALIZED VIEW `analytics`.`daily_pv` INACTIVE")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreateMaterializedView(&MaterializedView{
+		Database: types.StringValue("analytics"),
+		Name:     types.StringValue("daily_pv"),
+		Query:    types.StringValue("SELECT dt, count(*) AS pv FROM events GROUP BY dt;"),
+		Comment:  types.StringValue("page views"),
+		Refresh: &MaterializedViewRefresh{
+			Type:     types.StringValue(refreshAsync),
+			Moment:   types.StringValue(refreshDeferred),
+			Start:    types.StringValue("2025-01-01 00:00:00"),
+			Interval: types.StringValue("1 DAY"),
+		},
+		PartitionBy: types.StringValue("date_trunc('day', dt)"),
+		Distribution: &TableDistribution{
+			Type:    types.StringValue(distributionHash),
+			Columns: stringList([]string{"dt"}),
+			Buckets: types.Int64Value(4),
+		},
+		OrderBy: types.ListNull(types.StringType),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"partition_refresh_number": types.StringValue("3"),
+			"resource_group":           types.StringValue("rg_mv"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("CreateMaterializedView failed: %v", err)
+	}
+
+	err = client.SetMaterializedViewRefresh("analytics", "daily_pv", &MaterializedViewRefresh{
+		Type:     types.StringValue(refreshManual),
+		Moment:   types.StringValue(refreshImmediate),
+		Start:    types.StringNull(),
+		Interval: types.StringNull(),
+	})
+	if err != nil {
+		t.Fatalf("SetMaterializedViewRefresh failed: %v", err)
+	}
+	err = client.SetMaterializedViewProperties("analytics", "daily_pv", map[string]string{
+		"resource_group":            "rg_default",
+		"query_rewrite_consistency": "LOOSE",
+	})
+	if err != nil {
+		t.Fatalf("SetMaterializedViewProperties failed: %v", err)
+	}
+	if err := client.SetMaterializedViewActive("analytics", "daily_pv", false); err != nil {
+		t.Fatalf("SetMaterializedViewActive failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+const testMaterializedViewDDL = "CREATE MATERIALIZED VIEW `daily_pv` (`dt`, `pv`)\n" +
+	"COMMENT \"page views\"\n" +
+	"PARTITION BY date_trunc('day', `dt`)\n" +
+	"DISTRIBUTED BY HASH(`dt`) BUCKETS 4 \n" +
+	"REFRESH ASYNC START(\"2025-01-01 00:00:00\") EVERY(INTERVAL 1 DAY)\n" +
+	"PROPERTIES (\n" +
+	"\"partition_refresh_number\" = \"3\",\n" +
+	"\"replication_num\" = \"1\"\n" +
+	")\n" +
+	"AS SELECT `events`.`dt`, count(*) AS `pv`\n" +
+	"FROM `analytics`.`events`\n" +
+	"GROUP BY `events`.`dt`;"
+
+func TestParseMaterializedView(t *testing.T) {
+	got := parseMaterializedView(testMaterializedViewDDL)
+
+	if got.Comment.ValueString() != "page views" {
+		t.Errorf("Comment = %v, want page views", got.Comment)
+	}
+	if got.PartitionBy.ValueString() != "date_trunc('day', `dt`)" {
+		t.Errorf("PartitionBy = %v", got.PartitionBy)
+	}
+	if !sameExpression(got.PartitionBy.ValueString(), "date_trunc('day',dt)") {
+		t.Errorf("PartitionBy %v should match the configured expression", got.PartitionBy)
+	}
+	if d := got.Distribution; d == nil || d.Buckets.ValueInt64() != 4 || !reflect.DeepEqual(listValues(d.Columns), []string{"dt"}) {
+		t.Errorf("Distribution = %+v", d)
+	}
+
+	r := got.Refresh
+	if r == nil {
+		t.Fatal("Refresh is nil")
+	}
+	if r.Type.ValueString() != refreshAsync || r.Start.ValueString() != "2025-01-01 00:00:00" || r.Interval.ValueString() != "1 DAY" || !r.Moment.IsNull() {
+		t.Errorf("Refresh = %+v", r)
+	}
+
+	props := mapValues(got.Properties)
+	if !reflect.DeepEqual(props, map[string]string{"partition_refresh_number": "3", "replication_num": "1"}) {
+		t.Errorf("Properties = %v", props)
+	}
+
+	wantQuery := "SELECT `events`.`dt`, count(*) AS `pv`\nFROM `analytics`.`events`\nGROUP BY `events`.`dt`;"
+	if got.Query.ValueString() != wantQuery {
+		t.Errorf("Query = %q, want %q", got.Query.ValueString(), wantQuery)
+	}
+}
+
+func TestGetMaterializedView(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	query := "SELECT REFRESH_TYPE, IS_ACTIVE, MATERIALIZED_VIEW_DEFINITION FROM information_schema.materialized_views WHERE TABLE_SCHEMA = 'analytics' AND TABLE_NAME = "
+	mock.ExpectQuery(regexp.QuoteMeta(query + "'daily_pv'")).WillReturnRows(
+		sqlmock.NewRows([]string{"REFRESH_TYPE", "IS_ACTIVE", "MATERIALIZED_VIEW_DEFINITION"}).
+			AddRow("MANUAL", "false", "CREATE MATERIALIZED VIEW `daily_pv`\nDISTRIBUTED BY RANDOM\nAS SELECT 1"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(query + "'missing'")).
+		WillReturnRows(sqlmock.NewRows([]string{"REFRESH_TYPE", "IS_ACTIVE", "MATERIALIZED_VIEW_DEFINITION"}))
+
+	got, err := client.GetMaterializedView("analytics", "daily_pv")
+	if err != nil {
+		t.Fatalf("GetMaterializedView failed: %v", err)
+	}
+	if got.Active.ValueBool() {
+		t.Error("Active = true, want false")
+	}
+	if got.Refresh == nil || got.Refresh.Type.ValueString() != refreshManual {
+		t.Errorf("Refresh = %+v, want MANUAL", got.Refresh)
+	}
+	if got.Query.ValueString() != "SELECT 1" {
+		t.Errorf("Query = %v, want SELECT 1", got.Query)
+	}
+
+	if _, err := client.GetMaterializedView("analytics", "missing"); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package starrocks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Pipe properties managed by typed attributes.
+const (
+	pipeAutoIngest   = "AUTO_INGEST"
+	pipePollInterval = "POLL_INTERVAL"
+	pipeBatchSize    = "BATCH_SIZE"
+	pipeBatchFiles   = "BATCH_FILES"
+)
+
+type Pipe struct {
+	Database     types.String
+	Name         types.String
+	Query        types.String
+	Credentials  map[string]string
+	AutoIngest   types.Bool
+	PollInterval types.Int64
+	BatchSize    types.String
+	BatchFiles   types.Int64
+	Properties   types.Map
+	Suspended    types.Bool
+	Table        types.String
+}
+
+// pipeProperties renders the typed pipe attributes and extra properties as
+// the PROPERTIES of a pipe.
+func pipeProperties(p *Pipe) map[string]string {
+	props := mapValues(p.Properties)
+	if props == nil {
+		props = make(map[string]string)
+	}
+	if v := p.AutoIngest; !v.IsNull() && !v.IsUnknown() {
+		props[pipeAutoIngest] = strconv.FormatBool(v.ValueBool())
+	}
+	if v := p.PollInterval; !v.IsNull() && !v.IsUnknown() {
+		props[pipePollInterval] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	if v := p.BatchSize; !v.IsNull() && !v.IsUnknown() {
+		props[pipeBatchSize] = v.ValueString()
+	}
+	if v := p.BatchFiles; !v.IsNull() && !v.IsUnknown() {
+		props[pipeBatchFiles] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	return props
+}
+
+var pipeFilesPattern = regexp.MustCompile(`(?i)\bFILES\s*\(`)
+
+// pipeQuery returns the INSERT statement of a pipe with credentials added to
+// the parameters of its FILES() table function, so that they do not have to
+// be written into the query.
+func pipeQuery(query string, credentials map[string]string) (string, error) {
+	query = viewQuery(query)
+	if len(credentials) == 0 {
+		return query, nil
+	}
+
+	loc := pipeFilesPattern.FindStringIndex(query)
+	if loc == nil {
+		return "", fmt.Errorf("query does not call FILES(), so credentials cannot be added")
+	}
+	rest := strings.TrimSpace(query[loc[1]:])
+	sep := ", "
+	if strings.HasPrefix(rest, ")") {
+		sep = ""
+	}
+	return query[:loc[1]] + quotePropertyList(credentials) + sep + query[loc[1]:], nil
+}
+
+func createPipeStatement(p *Pipe) (string, error) {
+	query, err := pipeQuery(p.Query.ValueString(), p.Credentials)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString("CREATE PIPE ")
+	b.WriteString(tableIdentifier(p.Database.ValueString(), p.Name.ValueString()))
+	if props := pipeProperties(p); len(props) > 0 {
+		b.WriteString("\nPROPERTIES (" + quotePropertyList(props) + ")")
+	}
+	b.WriteString("\nAS " + query)
+	return b.String(), nil
+}
+
+func (c *Client) CreatePipe(p *Pipe) error {
+	query, err := createPipeStatement(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.Exec(query)
+	return err
+}
+
+// SetPipeProperties changes properties of a pipe.
+func (c *Client) SetPipeProperties(database, name string, props map[string]string) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("ALTER PIPE %s SET (%s)", tableIdentifier(database, name), quotePropertyList(props))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "pipe", name)
+}
+
+// SetPipeSuspended suspends or resumes a pipe.
+func (c *Client) SetPipeSuspended(database, name string, suspended bool) error {
+	action := "RESUME"
+	if suspended {
+		action = "SUSPEND"
+	}
+	_, err := c.db.Exec(fmt.Sprintf("ALTER PIPE %s %s", tableIdentifier(database, name), action))
+	return wrapNotFound(err, "pipe", name)
+}
+
+// GetPipe reads a pipe from information_schema.pipes. The query is not
+// reported there and is left null.
+func (c *Client) GetPipe(database, name string) (*Pipe, error) {
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SELECT PIPE_NAME, STATE, TABLE_NAME, PROPERTIES FROM information_schema.pipes "+
+			"WHERE DATABASE_NAME = %s AND PIPE_NAME = %s",
+		quoteString(database), quoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "pipe", Name: name}
+	}
+	row := rows[0]
+
+	raw, err := parseJSONProperties(row.get("properties"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing properties of pipe %q: %w", name, err)
+	}
+
+	// Typed properties are matched case-insensitively; keys of extra
+	// properties are kept as reported.
+	props := make(map[string]string)
+	extra := make(map[string]string)
+	for k, v := range raw {
+		switch key := strings.ToUpper(k); key {
+		case pipeAutoIngest, pipePollInterval, pipeBatchSize, pipeBatchFiles:
+			props[key] = v
+		default:
+			extra[k] = v
+		}
+	}
+
+	p := &Pipe{
+		Database:     types.StringValue(database),
+		Name:         types.StringValue(name),
+		Query:        types.StringNull(),
+		AutoIngest:   types.BoolNull(),
+		PollInterval: parseInt64Column(props[pipePollInterval], false),
+		BatchSize:    parseStringColumn(props[pipeBatchSize]),
+		BatchFiles:   parseInt64Column(props[pipeBatchFiles], false),
+		Properties:   stringMap(extra),
+		Suspended:    types.BoolValue(strings.HasPrefix(strings.ToUpper(row.get("state")), "SUSPEND")),
+		Table:        parseStringColumn(row.get("table_name")),
+	}
+	if v, err := strconv.ParseBool(props[pipeAutoIngest]); err == nil {
+		p.AutoIngest = types.BoolValue(v)
+	}
+
+	return p, nil
+}
+
+func (c *Client) DropPipe(database, name string) error {
+	_, err := c.db.Exec("DROP PIPE " + tableIdentifier(database, name))
+	return wrapNotFound(err, "pipe", name)
+}
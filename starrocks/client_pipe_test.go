@@ -0,0 +1,169 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPipeQuery(t *testing.T) {
+	creds := map[string]string{"aws.s3.access_key": "AKIA", "aws.s3.secret_key": "s3cr3t"}
+
+	tests := []struct {
+		name, query string
+		creds       map[string]string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:  "no credentials",
+			query: "INSERT INTO t SELECT * FROM FILES('path' = 's3://bucket/*.parquet');",
+			want:  "INSERT INTO t SELECT * FROM FILES('path' = 's3://bucket/*.parquet')",
+		},
+		{
+			name:  "credentials",
+			query: "INSERT INTO t SELECT * FROM files ('path' = 's3://bucket/*.parquet', 'format' = 'parquet')",
+			creds: creds,
+			want:  "INSERT INTO t SELECT * FROM files ('aws.s3.access_key' = 'AKIA', 'aws.s3.secret_key' = 's3cr3t', 'path' = 's3://bucket/*.parquet', 'format' = 'parquet')",
+		},
+		{
+			name:  "empty parameters",
+			query: "INSERT INTO t SELECT * FROM FILES()",
+			creds: map[string]string{"aws.s3.region": "us-west-2"},
+			want:  "INSERT INTO t SELECT * FROM FILES('aws.s3.region' = 'us-west-2')",
+		},
+		{
+			name:    "no FILES()",
+			query:   "INSERT INTO t SELECT * FROM s",
+			creds:   creds,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := pipeQuery(tt.query, tt.creds)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: pipeQuery() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: pipeQuery() =\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCreatePipe(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE PIPE `analytics`.`orders_pipe`\n" +
+		"PROPERTIES ('AUTO_INGEST' = 'true', 'BATCH_SIZE' = '1GB', 'POLL_INTERVAL' = '60', 'task.query_timeout' = '3600')\n" +
+		"AS INSERT INTO orders SELECT * FROM FILES('aws.s3.secret_key' = 's3cr3t', 'path' = 's3://bucket/orders/*')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER PIPE `analytics`.`orders_pipe` SET ('POLL_INTERVAL' = '300')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER PIPE `analytics`.`orders_pipe` SUSPEND")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER PIPE `analytics`.`orders_pipe` RESUME")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DROP PIPE `analytics`.`orders_pipe`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreatePipe(&Pipe{
+		Database:     types.StringValue("analytics"),
+		Name:         types.StringValue("orders_pipe"),
+		Query:        types.StringValue("INSERT INTO orders SELECT * FROM FILES('path' = 's3://bucket/orders/*')"),
+		Credentials:  map[string]string{"aws.s3.secret_key": "s3cr3t"},
+		AutoIngest:   types.BoolValue(true),
+		PollInterval: types.Int64Value(60),
+		BatchSize:    types.StringValue("1GB"),
+		BatchFiles:   types.Int64Unknown(),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"task.query_timeout": types.StringValue("3600"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("CreatePipe failed: %v", err)
+	}
+	if err := client.SetPipeProperties("analytics", "orders_pipe", nil); err != nil {
+		t.Fatalf("SetPipeProperties failed: %v", err)
+	}
+	if err := client.SetPipeProperties("analytics", "orders_pipe", map[string]string{pipePollInterval: "300"}); err != nil {
+		t.Fatalf("SetPipeProperties failed: %v", err)
+	}
+	if err := client.SetPipeSuspended("analytics", "orders_pipe", true); err != nil {
+		t.Fatalf("SetPipeSuspended failed: %v", err)
+	}
+	if err := client.SetPipeSuspended("analytics", "orders_pipe", false); err != nil {
+		t.Fatalf("SetPipeSuspended failed: %v", err)
+	}
+	if err := client.DropPipe("analytics", "orders_pipe"); err != nil {
+		t.Fatalf("DropPipe failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetPipe(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT PIPE_NAME, STATE, TABLE_NAME, PROPERTIES FROM information_schema.pipes WHERE DATABASE_NAME = 'analytics' AND PIPE_NAME = 'orders_pipe'")).
+		WillReturnRows(sqlmock.NewRows([]string{"PIPE_NAME", "STATE", "TABLE_NAME", "PROPERTIES"}).AddRow(
+			"orders_pipe", "SUSPEND", "analytics.orders",
+			`{"auto_ingest":"false","poll_interval":"300","batch_size":"1073741824","task.query_timeout":"3600"}`,
+		))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.pipes WHERE DATABASE_NAME = 'analytics' AND PIPE_NAME = 'missing'")).
+		WillReturnRows(sqlmock.NewRows([]string{"PIPE_NAME", "STATE", "TABLE_NAME", "PROPERTIES"}))
+
+	got, err := client.GetPipe("analytics", "orders_pipe")
+	if err != nil {
+		t.Fatalf("GetPipe failed: %v", err)
+	}
+	if !got.Suspended.ValueBool() || got.AutoIngest.ValueBool() || got.PollInterval.ValueInt64() != 300 || !got.BatchFiles.IsNull() {
+		t.Errorf("GetPipe = %+v", got)
+	}
+	if got.Table.ValueString() != "analytics.orders" {
+		t.Errorf("Table = %v", got.Table)
+	}
+	if props := mapValues(got.Properties); !reflect.DeepEqual(props, map[string]string{"task.query_timeout": "3600"}) {
+		t.Errorf("Properties = %v", props)
+	}
+
+	// batch_size is kept when set, since the server reports it in bytes.
+	model := pipeResourceModel{
+		Query:      NewSQLQueryValue("INSERT INTO orders SELECT * FROM FILES()"),
+		BatchSize:  types.StringValue("1GB"),
+		Properties: types.MapNull(types.StringType),
+	}
+	if diags := model.refresh(got); diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	if model.BatchSize.ValueString() != "1GB" || !model.Suspended.ValueBool() || !model.Properties.IsNull() {
+		t.Errorf("refresh = %+v", model)
+	}
+
+	if _, err := client.GetPipe("analytics", "missing"); !IsNotFound(err) {
+		t.Errorf("GetPipe(missing) error = %v, want not found", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
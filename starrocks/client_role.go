@@ -0,0 +1,145 @@
+package starrocks
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Role struct {
+	Name        types.String
+	Comment     types.String
+	ParentRoles types.Set
+}
+
+type RoleModel interface {
+	GetName() types.String
+	GetComment() types.String
+}
+
+func (c *Client) CreateRole(r RoleModel) error {
+	query := "CREATE ROLE " + quoteIdentifier(r.GetName().ValueString())
+	if comment := r.GetComment(); !comment.IsNull() && !comment.IsUnknown() {
+		query += " COMMENT " + quoteString(comment.ValueString())
+	}
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetRoleComment replaces the comment of a role. A null comment clears it.
+func (c *Client) SetRoleComment(name string, comment types.String) error {
+	query := "ALTER ROLE " + quoteIdentifier(name) + " SET COMMENT = " + quoteString(comment.ValueString())
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "role", name)
+}
+
+// GrantRolesToRole makes role inherit the privileges of the given roles.
+func (c *Client) GrantRolesToRole(role string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	query := "GRANT " + quoteIdentifiers(roles) + " TO ROLE " + quoteIdentifier(role)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// RevokeRolesFromRole removes inherited roles from role.
+func (c *Client) RevokeRolesFromRole(role string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	query := "REVOKE " + quoteIdentifiers(roles) + " FROM ROLE " + quoteIdentifier(role)
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "role", role)
+}
+
+func (c *Client) GetRole(name string) (*Role, error) {
+	rows, err := c.queryRows("SHOW ROLES")
+	if err != nil {
+		return nil, err
+	}
+
+	var role *Role
+	for _, row := range rows {
+		if row.get("name") == name {
+			role = &Role{
+				Name:    types.StringValue(name),
+				Comment: parseStringColumn(row.get("comment")),
+			}
+			break
+		}
+	}
+	if role == nil {
+		return nil, &NotFoundError{Kind: "role", Name: name}
+	}
+
+	grants, err := c.queryRows("SHOW GRANTS FOR ROLE " + quoteIdentifier(name))
+	if err != nil {
+		return nil, wrapNotFound(err, "role", name)
+	}
+
+	var parents []string
+	for _, row := range grants {
+		for _, stmt := range strings.Split(row.get("grants"), "\n") {
+			parents = append(parents, parseRoleGrant(stmt)...)
+		}
+	}
+	role.ParentRoles = stringSet(dedupeStrings(parents))
+
+	return role, nil
+}
+
+func (c *Client) DropRole(name string) error {
+	_, err := c.db.Exec("DROP ROLE " + quoteIdentifier(name))
+	return wrapNotFound(err, "role", name)
+}
+
+// parseRoleGrant returns the roles granted by a SHOW GRANTS statement such
+// as GRANT 'r1', 'r2' TO ROLE 'r3'. Privilege grants, which have an ON
+// clause, yield nil.
+func parseRoleGrant(stmt string) []string {
+	stmt = strings.TrimSuffix(strings.TrimSpace(stmt), ";")
+	upper := strings.ToUpper(stmt)
+	if !strings.HasPrefix(upper, "GRANT ") {
+		return nil
+	}
+	to := strings.LastIndex(upper, " TO ")
+	if to < 0 {
+		return nil
+	}
+
+	var roles []string
+	for _, item := range splitNameList(stmt[len("GRANT "):to]) {
+		name := unquoteName(item)
+		// An unquoted element with spaces is part of "priv ON object".
+		if name == item && strings.ContainsAny(item, " \t\n") {
+			return nil
+		}
+		if name != "" {
+			roles = append(roles, name)
+		}
+	}
+	return roles
+}
+
+// stringSet builds a set from values, dropping duplicates, or a null set
+// when there are none.
+func stringSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	seen := make(map[string]bool, len(values))
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			elems = append(elems, types.StringValue(v))
+		}
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
@@ -0,0 +1,132 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE ROLE `analyst` COMMENT 'read-only \\'analytics\\''")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("GRANT `public`, `reader` TO ROLE `analyst`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("REVOKE `reader` FROM ROLE `analyst`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER ROLE `analyst` SET COMMENT = ''")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreateRole(&roleResourceModel{
+		Name:    types.StringValue("analyst"),
+		Comment: types.StringValue("read-only 'analytics'"),
+	})
+	if err != nil {
+		t.Fatalf("CreateRole failed: %v", err)
+	}
+	if err := client.GrantRolesToRole("analyst", []string{"public", "reader"}); err != nil {
+		t.Fatalf("GrantRolesToRole failed: %v", err)
+	}
+	if err := client.GrantRolesToRole("analyst", nil); err != nil {
+		t.Fatalf("GrantRolesToRole failed: %v", err)
+	}
+	if err := client.RevokeRolesFromRole("analyst", []string{"reader"}); err != nil {
+		t.Fatalf("RevokeRolesFromRole failed: %v", err)
+	}
+	if err := client.SetRoleComment("analyst", types.StringNull()); err != nil {
+		t.Fatalf("SetRoleComment failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW ROLES")).WillReturnRows(
+		sqlmock.NewRows([]string{"Name", "Builtin", "Comment"}).
+			AddRow("root", "true", "built-in root role").
+			AddRow("analyst", "false", "analytics team"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GRANTS FOR ROLE `analyst`")).WillReturnRows(
+		sqlmock.NewRows([]string{"RoleName", "Catalog", "Grants"}).
+			AddRow("analyst", nil, "GRANT 'reader', 'public' TO ROLE 'analyst'").
+			AddRow("analyst", "default_catalog", "GRANT SELECT ON TABLE analytics.events TO ROLE 'analyst'").
+			AddRow("analyst", nil, "GRANT 'etl' TO ROLE 'analyst'\nGRANT INSERT ON TABLE analytics.events TO ROLE 'analyst'\nGRANT 'reader' TO ROLE 'analyst'"),
+	)
+
+	role, err := client.GetRole("analyst")
+	if err != nil {
+		t.Fatalf("GetRole failed: %v", err)
+	}
+	if role.Comment.ValueString() != "analytics team" {
+		t.Errorf("Comment = %v, want analytics team", role.Comment)
+	}
+	if got := stringSetValues(role.ParentRoles); !reflect.DeepEqual(got, []string{"etl", "public", "reader"}) {
+		t.Errorf("ParentRoles = %v, want [etl public reader]", got)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetRole_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW ROLES")).WillReturnRows(
+		sqlmock.NewRows([]string{"Name", "Builtin", "Comment"}).AddRow("root", "true", nil),
+	)
+
+	if _, err := client.GetRole("missing"); !IsNotFound(err) {
+		t.Errorf("GetRole error = %v, want not found", err)
+	}
+}
+
+func TestParseRoleGrant(t *testing.T) {
+	tests := map[string][]string{
+		"GRANT 'reader' TO ROLE 'analyst'":                  {"reader"},
+		"GRANT `a,b`, c TO ROLE x":                          {"a,b", "c"},
+		"GRANT 'r1', 'r2' TO 'jack'@'%'":                    {"r1", "r2"},
+		"GRANT SELECT ON TABLE db.t TO ROLE 'analyst'":      nil,
+		"GRANT SELECT, INSERT ON TABLE db.t TO USER 'jack'": nil,
+		"REVOKE 'reader' FROM ROLE 'analyst'":               nil,
+	}
+
+	for stmt, want := range tests {
+		if got := parseRoleGrant(stmt); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseRoleGrant(%q) = %v, want %v", stmt, got, want)
+		}
+	}
+}
+
+func TestDiffStrings(t *testing.T) {
+	added, removed := diffStrings([]string{"a", "b"}, []string{"b", "c"})
+	if !reflect.DeepEqual(added, []string{"c"}) || !reflect.DeepEqual(removed, []string{"a"}) {
+		t.Errorf("diffStrings() = %v, %v, want [c], [a]", added, removed)
+	}
+}
@@ -0,0 +1,310 @@
+package starrocks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Routine load job states that can be requested.
+const (
+	routineLoadRunning = "RUNNING"
+	routineLoadPaused  = "PAUSED"
+)
+
+// Routine load job properties managed by typed attributes.
+const (
+	routineLoadDesiredConcurrentNumber = "desired_concurrent_number"
+	routineLoadMaxBatchInterval        = "max_batch_interval"
+	routineLoadFormat                  = "format"
+	routineLoadJSONPaths               = "jsonpaths"
+)
+
+// kafkaPropertyPrefix is prepended to custom Kafka client properties, such as
+// security.protocol, in FROM KAFKA.
+const kafkaPropertyPrefix = "property."
+
+type RoutineLoadKafka struct {
+	BrokerList  types.String
+	Topic       types.String
+	Partitions  types.List
+	Offsets     types.List
+	Properties  types.Map
+	Credentials types.Map
+}
+
+type RoutineLoad struct {
+	Database                types.String
+	Name                    types.String
+	Table                   types.String
+	Columns                 types.List
+	Where                   types.String
+	DesiredConcurrentNumber types.Int64
+	MaxBatchInterval        types.Int64
+	Format                  types.String
+	JSONPaths               types.String
+	Properties              types.Map
+	Kafka                   *RoutineLoadKafka
+	State                   types.String
+}
+
+var routineLoadKafkaAttrTypes = map[string]attr.Type{
+	"broker_list": types.StringType,
+	"topic":       types.StringType,
+	"partitions":  types.ListType{ElemType: types.Int64Type},
+	"offsets":     types.ListType{ElemType: types.StringType},
+	"properties":  types.MapType{ElemType: types.StringType},
+	"credentials": types.MapType{ElemType: types.StringType},
+}
+
+// routineLoadLoadClauses renders the COLUMNS and WHERE clauses of a routine
+// load job.
+func routineLoadLoadClauses(columns types.List, where types.String) []string {
+	var clauses []string
+	if cols := listValues(columns); len(cols) > 0 {
+		clauses = append(clauses, "COLUMNS ("+strings.Join(cols, ", ")+")")
+	}
+	if !where.IsNull() && !where.IsUnknown() {
+		clauses = append(clauses, "WHERE "+where.ValueString())
+	}
+	return clauses
+}
+
+// routineLoadJobProperties renders the typed job attributes and extra
+// properties as the PROPERTIES of a routine load job.
+func routineLoadJobProperties(rl *RoutineLoad) map[string]string {
+	props := mapValues(rl.Properties)
+	if props == nil {
+		props = make(map[string]string)
+	}
+	if v := rl.DesiredConcurrentNumber; !v.IsNull() && !v.IsUnknown() {
+		props[routineLoadDesiredConcurrentNumber] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	if v := rl.MaxBatchInterval; !v.IsNull() && !v.IsUnknown() {
+		props[routineLoadMaxBatchInterval] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	if v := rl.Format; !v.IsNull() && !v.IsUnknown() {
+		props[routineLoadFormat] = v.ValueString()
+	}
+	if v := rl.JSONPaths; !v.IsNull() && !v.IsUnknown() {
+		props[routineLoadJSONPaths] = v.ValueString()
+	}
+	return props
+}
+
+// kafkaSourceProperties renders the FROM KAFKA properties of a routine load
+// job. The broker list and topic are only included when withSource is set,
+// since they cannot be altered.
+func kafkaSourceProperties(k *RoutineLoadKafka, withSource bool) map[string]string {
+	props := make(map[string]string)
+	if withSource {
+		props["kafka_broker_list"] = k.BrokerList.ValueString()
+		props["kafka_topic"] = k.Topic.ValueString()
+	}
+	if partitions := int64ListValues(k.Partitions); len(partitions) > 0 {
+		props["kafka_partitions"] = strings.Join(partitions, ",")
+	}
+	if offsets := listValues(k.Offsets); len(offsets) > 0 {
+		props["kafka_offsets"] = strings.Join(offsets, ",")
+	}
+	for _, m := range []types.Map{k.Properties, k.Credentials} {
+		for key, v := range mapValues(m) {
+			props[kafkaPropertyPrefix+key] = v
+		}
+	}
+	return props
+}
+
+// int64ListValues returns the known elements of an integer list as strings.
+func int64ListValues(list types.List) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var values []string
+	for _, elem := range list.Elements() {
+		if v, ok := elem.(types.Int64); ok && !v.IsNull() && !v.IsUnknown() {
+			values = append(values, strconv.FormatInt(v.ValueInt64(), 10))
+		}
+	}
+	return values
+}
+
+func createRoutineLoadStatement(rl *RoutineLoad) string {
+	var b strings.Builder
+	b.WriteString("CREATE ROUTINE LOAD ")
+	b.WriteString(tableIdentifier(rl.Database.ValueString(), rl.Name.ValueString()))
+	b.WriteString(" ON " + quoteIdentifier(rl.Table.ValueString()))
+	if clauses := routineLoadLoadClauses(rl.Columns, rl.Where); len(clauses) > 0 {
+		b.WriteString("\n" + strings.Join(clauses, ",\n"))
+	}
+	if props := routineLoadJobProperties(rl); len(props) > 0 {
+		b.WriteString("\nPROPERTIES (" + quotePropertyList(props) + ")")
+	}
+	b.WriteString("\nFROM KAFKA (" + quotePropertyList(kafkaSourceProperties(rl.Kafka, true)) + ")")
+	return b.String()
+}
+
+func (c *Client) CreateRoutineLoad(rl *RoutineLoad) error {
+	_, err := c.db.Exec(createRoutineLoadStatement(rl))
+	return err
+}
+
+// AlterRoutineLoad changes the load clauses, job properties and Kafka
+// properties of a paused routine load job. Empty parts are left unchanged.
+func (c *Client) AlterRoutineLoad(database, name string, clauses []string, jobProps, kafkaProps map[string]string) error {
+	if len(clauses) == 0 && len(jobProps) == 0 && len(kafkaProps) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("ALTER ROUTINE LOAD FOR " + tableIdentifier(database, name))
+	if len(clauses) > 0 {
+		b.WriteString("\n" + strings.Join(clauses, ",\n"))
+	}
+	if len(jobProps) > 0 {
+		b.WriteString("\nPROPERTIES (" + quotePropertyList(jobProps) + ")")
+	}
+	if len(kafkaProps) > 0 {
+		b.WriteString("\nFROM KAFKA (" + quotePropertyList(kafkaProps) + ")")
+	}
+
+	_, err := c.db.Exec(b.String())
+	return wrapNotFound(err, "routine load", name)
+}
+
+// PauseRoutineLoad pauses a routine load job.
+func (c *Client) PauseRoutineLoad(database, name string) error {
+	_, err := c.db.Exec("PAUSE ROUTINE LOAD FOR " + tableIdentifier(database, name))
+	return wrapNotFound(err, "routine load", name)
+}
+
+// ResumeRoutineLoad resumes a paused routine load job.
+func (c *Client) ResumeRoutineLoad(database, name string) error {
+	_, err := c.db.Exec("RESUME ROUTINE LOAD FOR " + tableIdentifier(database, name))
+	return wrapNotFound(err, "routine load", name)
+}
+
+// GetRoutineLoad reads a routine load job with SHOW ROUTINE LOAD, which only
+// lists jobs that have not been stopped or cancelled. Columns, the WHERE
+// filter, partitions, offsets and Kafka client properties are not reported
+// in a comparable form and are left null.
+func (c *Client) GetRoutineLoad(database, name string) (*RoutineLoad, error) {
+	rows, err := c.queryRows("SHOW ROUTINE LOAD FOR " + tableIdentifier(database, name))
+	if err != nil {
+		return nil, wrapNotFound(err, "routine load", name)
+	}
+
+	var row showRow
+	for _, r := range rows {
+		if r.get("name") == name {
+			row = r
+		}
+	}
+	if row == nil {
+		return nil, &NotFoundError{Kind: "routine load", Name: name}
+	}
+
+	state := strings.ToUpper(row.get("state"))
+	switch state {
+	case "STOPPED", "CANCELLED":
+		return nil, &NotFoundError{Kind: "routine load", Name: name}
+	case "NEED_SCHEDULE", "UNSTABLE":
+		// Both are reported for jobs that are running.
+		state = routineLoadRunning
+	}
+
+	jobProps, err := parseJSONProperties(row.get("jobproperties"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing job properties of routine load %q: %w", name, err)
+	}
+	sourceProps, err := parseJSONProperties(row.get("datasourceproperties"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing data source properties of routine load %q: %w", name, err)
+	}
+
+	rl := &RoutineLoad{
+		Database:                types.StringValue(database),
+		Name:                    types.StringValue(name),
+		Table:                   types.StringValue(row.get("tablename")),
+		Columns:                 types.ListNull(types.StringType),
+		Where:                   types.StringNull(),
+		DesiredConcurrentNumber: parseInt64Column(jobProps["desireTaskConcurrentNum"], false),
+		MaxBatchInterval:        parseInt64Column(jobProps["maxBatchIntervalS"], false),
+		Format:                  parseStringColumn(strings.ToLower(jobProps[routineLoadFormat])),
+		JSONPaths:               parseStringColumn(jobProps[routineLoadJSONPaths]),
+		Properties:              types.MapNull(types.StringType),
+		State:                   types.StringValue(state),
+	}
+	if strings.EqualFold(row.get("datasourcetype"), "KAFKA") {
+		rl.Kafka = &RoutineLoadKafka{
+			BrokerList:  parseStringColumn(sourceProps["brokerList"]),
+			Topic:       parseStringColumn(sourceProps["topic"]),
+			Partitions:  types.ListNull(types.Int64Type),
+			Offsets:     types.ListNull(types.StringType),
+			Properties:  types.MapNull(types.StringType),
+			Credentials: types.MapNull(types.StringType),
+		}
+	}
+
+	return rl, nil
+}
+
+// StopRoutineLoad stops a routine load job. Stopped jobs cannot be resumed.
+func (c *Client) StopRoutineLoad(database, name string) error {
+	_, err := c.db.Exec("STOP ROUTINE LOAD FOR " + tableIdentifier(database, name))
+	return wrapNotFound(err, "routine load", name)
+}
+
+func kafkaFromObject(obj types.Object) *RoutineLoadKafka {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	k := &RoutineLoadKafka{
+		BrokerList:  types.StringNull(),
+		Topic:       types.StringNull(),
+		Partitions:  types.ListNull(types.Int64Type),
+		Offsets:     types.ListNull(types.StringType),
+		Properties:  types.MapNull(types.StringType),
+		Credentials: types.MapNull(types.StringType),
+	}
+	if v, ok := attrs["broker_list"].(types.String); ok {
+		k.BrokerList = v
+	}
+	if v, ok := attrs["topic"].(types.String); ok {
+		k.Topic = v
+	}
+	if v, ok := attrs["partitions"].(types.List); ok {
+		k.Partitions = v
+	}
+	if v, ok := attrs["offsets"].(types.List); ok {
+		k.Offsets = v
+	}
+	if v, ok := attrs["properties"].(types.Map); ok {
+		k.Properties = v
+	}
+	if v, ok := attrs["credentials"].(types.Map); ok {
+		k.Credentials = v
+	}
+	return k
+}
+
+func kafkaToObject(k *RoutineLoadKafka) types.Object {
+	if k == nil {
+		return types.ObjectNull(routineLoadKafkaAttrTypes)
+	}
+
+	return types.ObjectValueMust(routineLoadKafkaAttrTypes, map[string]attr.Value{
+		"broker_list": k.BrokerList,
+		"topic":       k.Topic,
+		"partitions":  k.Partitions,
+		"offsets":     k.Offsets,
+		"properties":  k.Properties,
+		"credentials": k.Credentials,
+	})
+}
@@ -0,0 +1,196 @@
+package starrocks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRoutineLoad() *RoutineLoad {
+	return &RoutineLoad{
+		Database: types.StringValue("analytics"),
+		Name:     types.StringValue("orders_kafka"),
+		Table:    types.StringValue("orders"),
+		Columns: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("order_id"),
+			types.StringValue("ts"),
+			types.StringValue("dt = to_date(ts)"),
+		}),
+		Where:                   types.StringValue("order_id > 0"),
+		DesiredConcurrentNumber: types.Int64Value(3),
+		MaxBatchInterval:        types.Int64Null(),
+		Format:                  types.StringValue("json"),
+		JSONPaths:               types.StringValue(`["$.id","$.ts"]`),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"strict_mode": types.StringValue("true"),
+		}),
+		Kafka: &RoutineLoadKafka{
+			BrokerList: types.StringValue("kafka-1:9092,kafka-2:9092"),
+			Topic:      types.StringValue("orders"),
+			Partitions: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(0), types.Int64Value(1)}),
+			Offsets: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("OFFSET_BEGINNING"),
+				types.StringValue("OFFSET_END"),
+			}),
+			Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"security.protocol": types.StringValue("SASL_SSL"),
+			}),
+			Credentials: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"sasl.password": types.StringValue("s3cr3t"),
+			}),
+		},
+	}
+}
+
+func TestCreateRoutineLoadStatement(t *testing.T) {
+	want := "CREATE ROUTINE LOAD `analytics`.`orders_kafka` ON `orders`\n" +
+		"COLUMNS (order_id, ts, dt = to_date(ts)),\n" +
+		"WHERE order_id > 0\n" +
+		`PROPERTIES ('desired_concurrent_number' = '3', 'format' = 'json', 'jsonpaths' = '["$.id","$.ts"]', 'strict_mode' = 'true')` + "\n" +
+		"FROM KAFKA ('kafka_broker_list' = 'kafka-1:9092,kafka-2:9092', 'kafka_offsets' = 'OFFSET_BEGINNING,OFFSET_END', " +
+		"'kafka_partitions' = '0,1', 'kafka_topic' = 'orders', 'property.sasl.password' = 's3cr3t', 'property.security.protocol' = 'SASL_SSL')"
+
+	if got := createRoutineLoadStatement(testRoutineLoad()); got != want {
+		t.Errorf("createRoutineLoadStatement() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestAlterRoutineLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("PAUSE ROUTINE LOAD FOR `analytics`.`orders_kafka`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER ROUTINE LOAD FOR `analytics`.`orders_kafka`\nWHERE order_id > 10\nPROPERTIES ('desired_concurrent_number' = '5')\nFROM KAFKA ('property.sasl.password' = 'rotated')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("RESUME ROUTINE LOAD FOR `analytics`.`orders_kafka`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("STOP ROUTINE LOAD FOR `analytics`.`orders_kafka`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.PauseRoutineLoad("analytics", "orders_kafka"); err != nil {
+		t.Fatalf("PauseRoutineLoad failed: %v", err)
+	}
+	if err := client.AlterRoutineLoad("analytics", "orders_kafka", nil, nil, nil); err != nil {
+		t.Fatalf("AlterRoutineLoad failed: %v", err)
+	}
+	err = client.AlterRoutineLoad("analytics", "orders_kafka",
+		routineLoadLoadClauses(types.ListNull(types.StringType), types.StringValue("order_id > 10")),
+		map[string]string{"desired_concurrent_number": "5"},
+		map[string]string{"property.sasl.password": "rotated"},
+	)
+	if err != nil {
+		t.Fatalf("AlterRoutineLoad failed: %v", err)
+	}
+	if err := client.ResumeRoutineLoad("analytics", "orders_kafka"); err != nil {
+		t.Fatalf("ResumeRoutineLoad failed: %v", err)
+	}
+	if err := client.StopRoutineLoad("analytics", "orders_kafka"); err != nil {
+		t.Fatalf("StopRoutineLoad failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetRoutineLoad(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	cols := []string{"Id", "Name", "CreateTime", "PauseTime", "EndTime", "DbName", "TableName", "State",
+		"DataSourceType", "CurrentTaskNum", "JobProperties", "DataSourceProperties", "CustomProperties",
+		"Statistic", "Progress", "ReasonOfStateChanged"}
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW ROUTINE LOAD FOR `analytics`.`orders_kafka`")).WillReturnRows(
+		sqlmock.NewRows(cols).AddRow(
+			"10086", "orders_kafka", "2024-01-01 00:00:00", nil, nil, "default_cluster:analytics", "orders", "NEED_SCHEDULE",
+			"KAFKA", "1",
+			`{"desireTaskConcurrentNum":"3","maxBatchIntervalS":"10","format":"json","jsonpaths":"[\"$.id\"]","whereExpr":"*"}`,
+			`{"topic":"orders","currentKafkaPartitions":"0,1","brokerList":"kafka-1:9092"}`,
+			`{"security.protocol":"SASL_SSL"}`,
+			"{}", "{}", "",
+		),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW ROUTINE LOAD FOR `analytics`.`stopped`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Name", "State"}).AddRow("stopped", "STOPPED"),
+	)
+
+	got, err := client.GetRoutineLoad("analytics", "orders_kafka")
+	if err != nil {
+		t.Fatalf("GetRoutineLoad failed: %v", err)
+	}
+	if got.State.ValueString() != routineLoadRunning || got.Table.ValueString() != "orders" {
+		t.Errorf("GetRoutineLoad = %+v", got)
+	}
+	if got.DesiredConcurrentNumber.ValueInt64() != 3 || got.MaxBatchInterval.ValueInt64() != 10 || got.Format.ValueString() != "json" {
+		t.Errorf("job properties = %v, %v, %v", got.DesiredConcurrentNumber, got.MaxBatchInterval, got.Format)
+	}
+	if got.Kafka == nil || got.Kafka.Topic.ValueString() != "orders" || got.Kafka.BrokerList.ValueString() != "kafka-1:9092" {
+		t.Errorf("Kafka = %+v", got.Kafka)
+	}
+
+	// Attributes that are not reported keep their configured values.
+	model := routineLoadResourceModel{
+		Where:     types.StringValue("order_id > 0"),
+		JSONPaths: types.StringNull(),
+		Kafka:     kafkaToObject(testRoutineLoad().Kafka),
+	}
+	model.refresh(got)
+	if model.Where.ValueString() != "order_id > 0" || !model.JSONPaths.IsNull() {
+		t.Errorf("refresh changed untracked attributes: %+v", model)
+	}
+	kafka := kafkaFromObject(model.Kafka)
+	if kafka.BrokerList.ValueString() != "kafka-1:9092" || mapValues(kafka.Credentials)["sasl.password"] != "s3cr3t" {
+		t.Errorf("Kafka = %+v", kafka)
+	}
+
+	if _, err := client.GetRoutineLoad("analytics", "stopped"); !IsNotFound(err) {
+		t.Errorf("GetRoutineLoad(stopped) error = %v, want not found", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetRoutineLoad_RunningStates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	for _, state := range []string{"RUNNING", "NEED_SCHEDULE", "UNSTABLE"} {
+		mock.ExpectQuery(regexp.QuoteMeta("SHOW ROUTINE LOAD FOR `analytics`.`orders_kafka`")).WillReturnRows(
+			sqlmock.NewRows([]string{"Name", "TableName", "State", "JobProperties", "DataSourceProperties", "CustomProperties"}).
+				AddRow("orders_kafka", "orders", state, "{}", "{}", "{}"),
+		)
+
+		got, err := client.GetRoutineLoad("analytics", "orders_kafka")
+		if err != nil {
+			t.Fatalf("GetRoutineLoad(%s) failed: %v", state, err)
+		}
+		if got.State.ValueString() != routineLoadRunning {
+			t.Errorf("GetRoutineLoad(%s) state = %v, want %s", state, got.State, routineLoadRunning)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package starrocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Storage volume types.
+var storageVolumeTypes = []string{"S3", "HDFS", "AZBLOB", "ADLS2", "GS"}
+
+type StorageVolume struct {
+	Name       types.String
+	Type       types.String
+	Locations  types.List
+	Comment    types.String
+	Enabled    types.Bool
+	IsDefault  types.Bool
+	Properties map[string]string
+}
+
+func (c *Client) CreateStorageVolume(v *StorageVolume) error {
+	query := fmt.Sprintf(
+		"CREATE STORAGE VOLUME %s TYPE = %s LOCATIONS = (%s)",
+		quoteIdentifier(v.Name.ValueString()), strings.ToUpper(v.Type.ValueString()), quoteStrings(listValues(v.Locations)),
+	)
+	if !v.Comment.IsNull() && !v.Comment.IsUnknown() {
+		query += " COMMENT " + quoteString(v.Comment.ValueString())
+	}
+
+	props := make(map[string]string, len(v.Properties)+1)
+	for k, val := range v.Properties {
+		props[k] = val
+	}
+	if !v.Enabled.IsNull() && !v.Enabled.IsUnknown() {
+		props["enabled"] = strconv.FormatBool(v.Enabled.ValueBool())
+	}
+	query += quotePropertiesClause(props)
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetStorageVolumeComment replaces the comment of a storage volume. A null
+// comment clears it.
+func (c *Client) SetStorageVolumeComment(name string, comment types.String) error {
+	query := fmt.Sprintf("ALTER STORAGE VOLUME %s COMMENT = %s", quoteIdentifier(name), quoteString(comment.ValueString()))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "storage volume", name)
+}
+
+// SetStorageVolumeProperties changes properties of a storage volume, such as
+// credentials or the enabled flag.
+func (c *Client) SetStorageVolumeProperties(name string, props map[string]string) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("ALTER STORAGE VOLUME %s SET (%s)", quoteIdentifier(name), quotePropertyList(props))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "storage volume", name)
+}
+
+// SetDefaultStorageVolume makes a storage volume the default for new
+// databases and tables.
+func (c *Client) SetDefaultStorageVolume(name string) error {
+	_, err := c.db.Exec("SET " + quoteIdentifier(name) + " AS DEFAULT STORAGE VOLUME")
+	return wrapNotFound(err, "storage volume", name)
+}
+
+// GetStorageVolume reads a storage volume with DESC STORAGE VOLUME. Secrets
+// are masked in the returned properties.
+func (c *Client) GetStorageVolume(name string) (*StorageVolume, error) {
+	rows, err := c.queryRows("DESC STORAGE VOLUME " + quoteIdentifier(name))
+	if err != nil {
+		return nil, wrapNotFound(err, "storage volume", name)
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "storage volume", Name: name}
+	}
+	row := rows[0]
+
+	var locations []string
+	for _, loc := range strings.Split(row.get("location"), ",") {
+		if loc = strings.TrimSpace(loc); loc != "" {
+			locations = append(locations, loc)
+		}
+	}
+
+	props, err := parseJSONProperties(row.get("params"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing params of storage volume %q: %w", name, err)
+	}
+
+	return &StorageVolume{
+		Name:       types.StringValue(name),
+		Type:       types.StringValue(strings.ToUpper(row.get("type"))),
+		Locations:  stringList(locations),
+		Comment:    parseStringColumn(row.get("comment")),
+		Enabled:    types.BoolValue(strings.EqualFold(row.get("enabled"), "true")),
+		IsDefault:  types.BoolValue(strings.EqualFold(row.get("isdefault"), "true")),
+		Properties: props,
+	}, nil
+}
+
+// parseJSONProperties decodes properties reported as a JSON object, such as
+// the Params column of DESC STORAGE VOLUME: {"aws.s3.region":"us-west-2"}.
+func parseJSONProperties(s string) (map[string]string, error) {
+	props := make(map[string]string)
+	if strings.TrimSpace(s) == "" {
+		return props, nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, err
+	}
+	for k, v := range raw {
+		if str, ok := v.(string); ok {
+			props[k] = str
+		} else {
+			props[k] = fmt.Sprint(v)
+		}
+	}
+	return props, nil
+}
+
+func (c *Client) DropStorageVolume(name string) error {
+	_, err := c.db.Exec("DROP STORAGE VOLUME " + quoteIdentifier(name))
+	return wrapNotFound(err, "storage volume", name)
+}
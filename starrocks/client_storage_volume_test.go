@@ -0,0 +1,135 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateStorageVolume(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE STORAGE VOLUME `s3_volume` TYPE = S3 LOCATIONS = ('s3://bucket/a', 's3://bucket/b') COMMENT 'shared' PROPERTIES ('aws.s3.region' = 'us-west-2', 'enabled' = 'false')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER STORAGE VOLUME `s3_volume` COMMENT = 'renamed'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER STORAGE VOLUME `s3_volume` SET ('aws.s3.access_key' = 'AKIA', 'aws.s3.secret_key' = 'rotated')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET `s3_volume` AS DEFAULT STORAGE VOLUME")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DROP STORAGE VOLUME `s3_volume`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = client.CreateStorageVolume(&StorageVolume{
+		Name: types.StringValue("s3_volume"),
+		Type: types.StringValue("s3"),
+		Locations: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("s3://bucket/a"),
+			types.StringValue("s3://bucket/b"),
+		}),
+		Comment:    types.StringValue("shared"),
+		Enabled:    types.BoolValue(false),
+		Properties: map[string]string{"aws.s3.region": "us-west-2"},
+	})
+	if err != nil {
+		t.Fatalf("CreateStorageVolume failed: %v", err)
+	}
+	if err := client.SetStorageVolumeComment("s3_volume", types.StringValue("renamed")); err != nil {
+		t.Fatalf("SetStorageVolumeComment failed: %v", err)
+	}
+	if err := client.SetStorageVolumeProperties("s3_volume", nil); err != nil {
+		t.Fatalf("SetStorageVolumeProperties failed: %v", err)
+	}
+	err = client.SetStorageVolumeProperties("s3_volume", map[string]string{
+		"aws.s3.access_key": "AKIA",
+		"aws.s3.secret_key": "rotated",
+	})
+	if err != nil {
+		t.Fatalf("SetStorageVolumeProperties failed: %v", err)
+	}
+	if err := client.SetDefaultStorageVolume("s3_volume"); err != nil {
+		t.Fatalf("SetDefaultStorageVolume failed: %v", err)
+	}
+	if err := client.DropStorageVolume("s3_volume"); err != nil {
+		t.Fatalf("DropStorageVolume failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetStorageVolume(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("DESC STORAGE VOLUME `s3_volume`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Name", "Type", "IsDefault", "Location", "Params", "Enabled", "Comment"}).AddRow(
+			"s3_volume", "S3", "true", "s3://bucket/a, s3://bucket/b",
+			`{"aws.s3.region":"us-west-2","aws.s3.secret_key":"******","aws.s3.enable_partitioned_prefix":false}`,
+			"true", "",
+		),
+	)
+
+	got, err := client.GetStorageVolume("s3_volume")
+	if err != nil {
+		t.Fatalf("GetStorageVolume failed: %v", err)
+	}
+	if got.Type.ValueString() != "S3" || !got.IsDefault.ValueBool() || !got.Enabled.ValueBool() {
+		t.Errorf("GetStorageVolume = %+v", got)
+	}
+	if !got.Comment.IsNull() {
+		t.Errorf("Comment = %v, want null", got.Comment)
+	}
+	if locations := listValues(got.Locations); !reflect.DeepEqual(locations, []string{"s3://bucket/a", "s3://bucket/b"}) {
+		t.Errorf("Locations = %v", locations)
+	}
+	want := map[string]string{
+		"aws.s3.region":                    "us-west-2",
+		"aws.s3.secret_key":                "******",
+		"aws.s3.enable_partitioned_prefix": "false",
+	}
+	if !reflect.DeepEqual(got.Properties, want) {
+		t.Errorf("Properties = %v, want %v", got.Properties, want)
+	}
+
+	// Masked credentials are kept from the configuration and untracked
+	// properties are ignored.
+	model := storageVolumeResourceModel{
+		Name: types.StringValue("s3_volume"),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"aws.s3.region": types.StringValue("us-east-1"),
+		}),
+		Credentials: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"aws.s3.secret_key": types.StringValue("s3cr3t"),
+		}),
+	}
+	if diags := model.refresh(got); diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	if props := mapValues(model.Properties); !reflect.DeepEqual(props, map[string]string{"aws.s3.region": "us-west-2"}) {
+		t.Errorf("Properties = %v", props)
+	}
+	if creds := mapValues(model.Credentials); creds["aws.s3.secret_key"] != "s3cr3t" {
+		t.Errorf("Credentials = %v", creds)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
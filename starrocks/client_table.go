@@ -0,0 +1,921 @@
+package starrocks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Table key models.
+const (
+	tableKeyDuplicate = "DUPLICATE"
+	tableKeyAggregate = "AGGREGATE"
+	tableKeyUnique    = "UNIQUE"
+	tableKeyPrimary   = "PRIMARY"
+)
+
+// Partitioning and distribution types.
+const (
+	partitionRange      = "RANGE"
+	partitionList       = "LIST"
+	partitionExpression = "EXPRESSION"
+	distributionHash    = "HASH"
+	distributionRandom  = "RANDOM"
+)
+
+// partitionMaxValue is the upper bound of an unbounded range partition.
+const partitionMaxValue = "MAXVALUE"
+
+var tableKeyTypes = []string{tableKeyDuplicate, tableKeyAggregate, tableKeyUnique, tableKeyPrimary}
+
+var columnAggregationTypes = []string{
+	"SUM", "MAX", "MIN", "REPLACE", "REPLACE_IF_NOT_NULL", "HLL_UNION", "BITMAP_UNION", "PERCENTILE_UNION",
+}
+
+// columnTypePattern matches column types such as "VARCHAR(64)",
+// "DECIMAL(10, 2)" or "MAP<INT, ARRAY<STRING>>". Types cannot be quoted,
+// so anything that could end the type is rejected.
+var columnTypePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*( ?[(<][A-Za-z0-9_<>(), ]*[)>])?$`)
+
+// validColumnType reports whether t is a single column type: it matches
+// columnTypePattern and its brackets only close at the very end.
+func validColumnType(t string) bool {
+	if !columnTypePattern.MatchString(t) {
+		return false
+	}
+
+	depth := 0
+	for i, c := range t {
+		switch c {
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+			if depth < 0 || depth == 0 && i != len(t)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+type TableColumn struct {
+	Name        types.String
+	Type        types.String
+	Nullable    types.Bool
+	Default     types.String
+	Comment     types.String
+	Aggregation types.String
+}
+
+type TablePartition struct {
+	Name   types.String
+	Values types.List
+}
+
+type TablePartitioning struct {
+	Type       types.String
+	Columns    types.List
+	Expression types.String
+	Partitions []TablePartition
+}
+
+type TableDistribution struct {
+	Type    types.String
+	Columns types.List
+	Buckets types.Int64
+}
+
+type Table struct {
+	Database     types.String
+	Name         types.String
+	Comment      types.String
+	Columns      []TableColumn
+	KeyType      types.String
+	KeyColumns   types.List
+	Partitioning *TablePartitioning
+	Distribution *TableDistribution
+	OrderBy      types.List
+	Properties   types.Map
+}
+
+var tableColumnAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"type":        types.StringType,
+	"nullable":    types.BoolType,
+	"default":     types.StringType,
+	"comment":     types.StringType,
+	"aggregation": types.StringType,
+}
+
+var tablePartitionAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"values": types.ListType{ElemType: types.StringType},
+}
+
+var tablePartitioningAttrTypes = map[string]attr.Type{
+	"type":       types.StringType,
+	"columns":    types.ListType{ElemType: types.StringType},
+	"expression": types.StringType,
+	"partitions": types.ListType{ElemType: types.ObjectType{AttrTypes: tablePartitionAttrTypes}},
+}
+
+var tableDistributionAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"columns": types.ListType{ElemType: types.StringType},
+	"buckets": types.Int64Type,
+}
+
+func tableIdentifier(database, name string) string {
+	return quoteQualifiedIdentifier(database, name)
+}
+
+func (c *Client) CreateTable(t *Table) error {
+	query, err := createTableStatement(t)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.Exec(query)
+	return err
+}
+
+// createTableStatement renders the CREATE TABLE statement for t.
+func createTableStatement(t *Table) (string, error) {
+	var b strings.Builder
+	b.WriteString("CREATE TABLE ")
+	b.WriteString(tableIdentifier(t.Database.ValueString(), t.Name.ValueString()))
+	b.WriteString(" (\n")
+	for i, col := range t.Columns {
+		def, err := columnDefinition(col)
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			b.WriteString(",\n")
+		}
+		b.WriteString("  ")
+		b.WriteString(def)
+	}
+	b.WriteString("\n) ENGINE=OLAP")
+
+	if keys := listValues(t.KeyColumns); len(keys) > 0 {
+		b.WriteString(fmt.Sprintf("\n%s KEY (%s)", t.KeyType.ValueString(), quoteIdentifiers(keys)))
+	}
+	if !t.Comment.IsNull() && !t.Comment.IsUnknown() {
+		b.WriteString("\nCOMMENT " + quoteString(t.Comment.ValueString()))
+	}
+	if p := t.Partitioning; p != nil {
+		clause, err := partitionClause(p)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString("\n" + clause)
+	}
+	if d := t.Distribution; d != nil {
+		b.WriteString("\n" + distributionClause(d))
+	}
+	if order := listValues(t.OrderBy); len(order) > 0 {
+		b.WriteString("\nORDER BY (" + quoteIdentifiers(order) + ")")
+	}
+	b.WriteString(quotePropertiesClause(mapValues(t.Properties)))
+
+	return b.String(), nil
+}
+
+// columnDefinition renders a column as used in CREATE TABLE, ADD COLUMN and
+// MODIFY COLUMN.
+func columnDefinition(col TableColumn) (string, error) {
+	colType := col.Type.ValueString()
+	if !validColumnType(colType) {
+		return "", fmt.Errorf("invalid type %q for column %q", colType, col.Name.ValueString())
+	}
+
+	def := quoteIdentifier(col.Name.ValueString()) + " " + colType
+	if agg := col.Aggregation; !agg.IsNull() && !agg.IsUnknown() {
+		def += " " + strings.ToUpper(agg.ValueString())
+	}
+	if col.Nullable.IsNull() || col.Nullable.IsUnknown() || col.Nullable.ValueBool() {
+		def += " NULL"
+	} else {
+		def += " NOT NULL"
+	}
+	if v := col.Default; !v.IsNull() && !v.IsUnknown() {
+		if strings.EqualFold(v.ValueString(), "CURRENT_TIMESTAMP") {
+			def += " DEFAULT CURRENT_TIMESTAMP"
+		} else {
+			def += " DEFAULT " + quoteString(v.ValueString())
+		}
+	}
+	if v := col.Comment; !v.IsNull() && !v.IsUnknown() {
+		def += " COMMENT " + quoteString(v.ValueString())
+	}
+	return def, nil
+}
+
+func partitionClause(p *TablePartitioning) (string, error) {
+	columns := quoteIdentifiers(listValues(p.Columns))
+
+	switch p.Type.ValueString() {
+	case partitionExpression:
+		if expr := p.Expression; !expr.IsNull() && !expr.IsUnknown() {
+			return "PARTITION BY " + expr.ValueString(), nil
+		}
+		return "PARTITION BY " + columns, nil
+	case partitionRange, partitionList:
+		defs := make([]string, len(p.Partitions))
+		for i, part := range p.Partitions {
+			defs[i] = partitionDefinition(p.Type.ValueString(), part)
+		}
+		return fmt.Sprintf("PARTITION BY %s(%s) (%s)", p.Type.ValueString(), columns, strings.Join(defs, ", ")), nil
+	}
+	return "", fmt.Errorf("unsupported partitioning type %q", p.Type.ValueString())
+}
+
+// partitionDefinition renders a range partition by its upper bound, or a
+// list partition by its values.
+func partitionDefinition(partitionType string, part TablePartition) string {
+	values := listValues(part.Values)
+	def := "PARTITION " + quoteIdentifier(part.Name.ValueString())
+	if partitionType == partitionList {
+		return def + " VALUES IN (" + quoteStrings(values) + ")"
+	}
+	if len(values) == 1 && strings.EqualFold(values[0], partitionMaxValue) {
+		return def + " VALUES LESS THAN " + partitionMaxValue
+	}
+	return def + " VALUES LESS THAN (" + quoteStrings(values) + ")"
+}
+
+func distributionClause(d *TableDistribution) string {
+	clause := "DISTRIBUTED BY RANDOM"
+	if d.Type.ValueString() == distributionHash {
+		clause = "DISTRIBUTED BY HASH(" + quoteIdentifiers(listValues(d.Columns)) + ")"
+	}
+	if b := d.Buckets; !b.IsNull() && !b.IsUnknown() && b.ValueInt64() > 0 {
+		clause += " BUCKETS " + strconv.FormatInt(b.ValueInt64(), 10)
+	}
+	return clause
+}
+
+func (c *Client) GetTable(database, name string) (*Table, error) {
+	var tableName, ddl string
+	query := "SHOW CREATE TABLE " + tableIdentifier(database, name)
+	if err := c.db.QueryRow(query).Scan(&tableName, &ddl); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NotFoundError{Kind: "table", Name: name}
+		}
+		return nil, wrapNotFound(err, "table", name)
+	}
+
+	t := parseCreateTable(ddl)
+	t.Database = types.StringValue(database)
+	t.Name = types.StringValue(name)
+
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SELECT COLUMN_NAME, COLUMN_TYPE, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT FROM information_schema.columns "+
+			"WHERE TABLE_SCHEMA = %s AND TABLE_NAME = %s ORDER BY ORDINAL_POSITION",
+		quoteString(database), quoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	aggregations := parseColumnAggregations(ddl)
+	for _, row := range rows {
+		colName := row.get("column_name")
+		col := TableColumn{
+			Name:        types.StringValue(colName),
+			Type:        types.StringValue(row.get("column_type")),
+			Nullable:    types.BoolValue(strings.EqualFold(row.get("is_nullable"), "yes")),
+			Default:     types.StringNull(),
+			Comment:     parseStringColumn(row.get("column_comment")),
+			Aggregation: types.StringNull(),
+		}
+		if v := row["column_default"]; v.Valid {
+			col.Default = types.StringValue(v.String)
+		}
+		if agg, ok := aggregations[colName]; ok {
+			col.Aggregation = types.StringValue(agg)
+		}
+		t.Columns = append(t.Columns, col)
+	}
+
+	return t, nil
+}
+
+var (
+	tableKeyPattern          = regexp.MustCompile(`(?i)\b(DUPLICATE|AGGREGATE|UNIQUE|PRIMARY) KEY\s*\(([^)]*)\)`)
+	tableDistributionPattern = regexp.MustCompile(`(?i)DISTRIBUTED BY (?:HASH\s*\(([^)]*)\)|(RANDOM))(?:\s+BUCKETS\s+(\d+))?`)
+	tableOrderByPattern      = regexp.MustCompile(`(?i)\bORDER BY\s*\(([^)]*)\)`)
+	tablePartitionPattern    = regexp.MustCompile(`(?i)PARTITION BY (?:(RANGE|LIST)\s*\(([^)]*)\)|(.+))`)
+	rangePartitionPattern    = regexp.MustCompile(`(?i)PARTITION\s+(\S+)\s+VALUES\s+\[\((.*?)\),\s*\((.*?)\)\)`)
+	listPartitionPattern     = regexp.MustCompile(`(?i)PARTITION\s+(\S+)\s+VALUES\s+IN\s+\((.*?)\)\s*(?:,|\)|$)`)
+	columnAggregationPattern = regexp.MustCompile(`^\s*` + "`((?:[^`]|``)+)`" + `\s+\S+(?:\s*\([^)]*\))?\s+(` + strings.Join(columnAggregationTypes, "|") + `)\b`)
+)
+
+// parseCreateTable extracts the table-level clauses of SHOW CREATE TABLE
+// output. Columns are read separately from information_schema.
+func parseCreateTable(ddl string) *Table {
+	t := &Table{
+		Comment:    types.StringNull(),
+		KeyType:    types.StringValue(tableKeyDuplicate),
+		KeyColumns: types.ListNull(types.StringType),
+		OrderBy:    types.ListNull(types.StringType),
+		Properties: types.MapNull(types.StringType),
+	}
+
+	// Everything after the column list.
+	tail := ddl
+	if idx := strings.Index(strings.ToUpper(ddl), ") ENGINE="); idx >= 0 {
+		tail = ddl[idx:]
+	}
+
+	if m := tableKeyPattern.FindStringSubmatch(tail); m != nil {
+		t.KeyType = types.StringValue(strings.ToUpper(m[1]))
+		t.KeyColumns = stringList(unquoteNames(m[2]))
+	}
+
+	if m := tableDistributionPattern.FindStringSubmatch(tail); m != nil {
+		t.Distribution = &TableDistribution{
+			Type:    types.StringValue(distributionHash),
+			Columns: stringList(unquoteNames(m[1])),
+			Buckets: types.Int64Null(),
+		}
+		if m[2] != "" {
+			t.Distribution.Type = types.StringValue(distributionRandom)
+			t.Distribution.Columns = types.ListNull(types.StringType)
+		}
+		if m[3] != "" {
+			t.Distribution.Buckets = parseInt64Column(m[3], true)
+		}
+	}
+
+	if m := tableOrderByPattern.FindStringSubmatch(tail); m != nil {
+		t.OrderBy = stringList(unquoteNames(m[1]))
+	}
+
+	// The table comment precedes the partition and distribution clauses;
+	// later string literals belong to partition values and properties.
+	end := len(tail)
+	for _, clause := range []string{"PARTITION BY", "DISTRIBUTED BY", "PROPERTIES"} {
+		if i := strings.Index(strings.ToUpper(tail), clause); i >= 0 && i < end {
+			end = i
+		}
+	}
+	if i := strings.Index(strings.ToUpper(tail[:end]), "COMMENT "); i >= 0 {
+		if comment, _, ok := nextStringLiteral(tail[i:end]); ok && comment != "" {
+			t.Comment = types.StringValue(comment)
+		}
+	}
+
+	t.Partitioning = parsePartitioning(tail)
+
+	t.Properties = stringMap(parseProperties(tail))
+
+	return t
+}
+
+// parsePartitioning reads the PARTITION BY clause and the partitions it
+// defines, or returns nil for unpartitioned tables.
+func parsePartitioning(tail string) *TablePartitioning {
+	m := tablePartitionPattern.FindStringSubmatchIndex(tail)
+	if m == nil {
+		return nil
+	}
+
+	p := &TablePartitioning{
+		Columns:    types.ListNull(types.StringType),
+		Expression: types.StringNull(),
+	}
+
+	if m[2] < 0 {
+		// Expression partitioning, e.g. date_trunc('day', dt) or `dt`, `city`.
+		expr := strings.TrimSpace(tail[m[6]:m[7]])
+		p.Type = types.StringValue(partitionExpression)
+		names := unquoteNames(expr)
+		simple := true
+		for _, name := range names {
+			if strings.ContainsAny(name, "( ") {
+				simple = false
+			}
+		}
+		if simple {
+			p.Columns = stringList(names)
+		} else {
+			p.Expression = types.StringValue(expr)
+		}
+		return p
+	}
+
+	p.Type = types.StringValue(strings.ToUpper(tail[m[2]:m[3]]))
+	p.Columns = stringList(unquoteNames(tail[m[4]:m[5]]))
+
+	rest := tail[m[1]:]
+	if end := strings.Index(strings.ToUpper(rest), "DISTRIBUTED BY"); end >= 0 {
+		rest = rest[:end]
+	}
+
+	if p.Type.ValueString() == partitionRange {
+		for _, pm := range rangePartitionPattern.FindAllStringSubmatch(rest, -1) {
+			p.Partitions = append(p.Partitions, TablePartition{
+				Name:   types.StringValue(unquoteName(pm[1])),
+				Values: stringList(partitionValues(pm[3])),
+			})
+		}
+	} else {
+		for _, pm := range listPartitionPattern.FindAllStringSubmatch(rest, -1) {
+			p.Partitions = append(p.Partitions, TablePartition{
+				Name:   types.StringValue(unquoteName(pm[1])),
+				Values: stringList(partitionValues(pm[2])),
+			})
+		}
+	}
+	return p
+}
+
+// partitionValues splits a tuple of partition values such as
+// "2024-01-01", MAXVALUE into its unquoted elements.
+func partitionValues(s string) []string {
+	var values []string
+	for _, v := range splitNameList(s) {
+		values = append(values, unquoteName(v))
+	}
+	return values
+}
+
+// parseColumnAggregations returns the aggregation type of each column of an
+// AGGREGATE KEY table, keyed by column name.
+func parseColumnAggregations(ddl string) map[string]string {
+	aggregations := make(map[string]string)
+	for _, line := range strings.Split(ddl, "\n") {
+		if m := columnAggregationPattern.FindStringSubmatch(line); m != nil {
+			aggregations[strings.ReplaceAll(m[1], "``", "`")] = strings.ToUpper(m[2])
+		}
+	}
+	return aggregations
+}
+
+// unquoteNames splits a comma-separated list of possibly quoted names.
+func unquoteNames(s string) []string {
+	var names []string
+	for _, name := range splitNameList(s) {
+		names = append(names, unquoteName(name))
+	}
+	return names
+}
+
+// integerDisplayWidth matches the display width MySQL clients print after
+// integer types, e.g. int(11).
+var integerDisplayWidth = regexp.MustCompile(`^(TINYINT|SMALLINT|INT|BIGINT|LARGEINT)\(\d+\)$`)
+
+// normalizeColumnType renders a column type in a canonical form, so that
+// the types in configuration and information_schema compare equal. The
+// element types of ARRAY, MAP and STRUCT are normalized too.
+func normalizeColumnType(t string) string {
+	t = strings.TrimSpace(t)
+	open := strings.Index(t, "<")
+	if open < 0 || !strings.HasSuffix(t, ">") {
+		return normalizeScalarType(t)
+	}
+
+	base := strings.ToUpper(strings.TrimSpace(t[:open]))
+	args := splitTypeArguments(t[open+1 : len(t)-1])
+	for i, arg := range args {
+		if base == "STRUCT" {
+			// Struct fields are "name type".
+			if fields := strings.Fields(arg); len(fields) > 1 {
+				name := strings.ToUpper(strings.Trim(fields[0], "`"))
+				args[i] = name + " " + normalizeColumnType(strings.TrimPrefix(arg, fields[0]))
+				continue
+			}
+		}
+		args[i] = normalizeColumnType(arg)
+	}
+	return base + "<" + strings.Join(args, ",") + ">"
+}
+
+// normalizeScalarType normalizes a type without element types, e.g.
+// int(11) or decimal64(10, 2).
+func normalizeScalarType(t string) string {
+	t = strings.ToUpper(strings.Join(strings.Fields(t), ""))
+	t = integerDisplayWidth.ReplaceAllString(t, "$1")
+	switch {
+	case t == "STRING":
+		return "VARCHAR(65533)"
+	case t == "INTEGER":
+		return "INT"
+	case t == "BOOL":
+		return "BOOLEAN"
+	case strings.HasPrefix(t, "DECIMAL32"), strings.HasPrefix(t, "DECIMAL64"):
+		return "DECIMAL" + t[len("DECIMAL64"):]
+	case strings.HasPrefix(t, "DECIMAL128"):
+		return "DECIMAL" + t[len("DECIMAL128"):]
+	case strings.HasPrefix(t, "DECIMALV2"):
+		return "DECIMAL" + t[len("DECIMALV2"):]
+	}
+	return t
+}
+
+// splitTypeArguments splits the element types of a nested type on the
+// commas that are not inside brackets.
+func splitTypeArguments(s string) []string {
+	var args []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(s[start:]))
+}
+
+// AddTableColumn adds a column first, after the named column, or at the end
+// when neither is given.
+func (c *Client) AddTableColumn(database, name string, col TableColumn, first bool, after string) error {
+	def, err := columnDefinition(col)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", tableIdentifier(database, name), def)
+	switch {
+	case first:
+		query += " FIRST"
+	case after != "":
+		query += " AFTER " + quoteIdentifier(after)
+	}
+	_, err = c.db.Exec(query)
+	return err
+}
+
+func (c *Client) ModifyTableColumn(database, name string, col TableColumn) error {
+	def, err := columnDefinition(col)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s", tableIdentifier(database, name), def)
+	_, err = c.db.Exec(query)
+	return err
+}
+
+func (c *Client) DropTableColumn(database, name, column string) error {
+	query := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", tableIdentifier(database, name), quoteIdentifier(column))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetTableComment replaces the table comment. A null comment clears it.
+func (c *Client) SetTableComment(database, name string, comment types.String) error {
+	query := fmt.Sprintf("ALTER TABLE %s COMMENT = %s", tableIdentifier(database, name), quoteString(comment.ValueString()))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetTableProperties changes table properties, one statement per property
+// as several properties cannot be changed at once.
+func (c *Client) SetTableProperties(database, name string, props map[string]string) error {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		query := fmt.Sprintf("ALTER TABLE %s SET (%s)", tableIdentifier(database, name), quoteProperty(k, props[k]))
+		if _, err := c.db.Exec(query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) SetTableOrderBy(database, name string, columns []string) error {
+	query := fmt.Sprintf("ALTER TABLE %s ORDER BY (%s)", tableIdentifier(database, name), quoteIdentifiers(columns))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetTableDistribution changes the bucket count of a table.
+func (c *Client) SetTableDistribution(database, name string, d *TableDistribution) error {
+	query := fmt.Sprintf("ALTER TABLE %s %s", tableIdentifier(database, name), distributionClause(d))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+func (c *Client) AddTablePartition(database, name, partitionType string, part TablePartition) error {
+	query := fmt.Sprintf("ALTER TABLE %s ADD %s", tableIdentifier(database, name), partitionDefinition(partitionType, part))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+func (c *Client) DropTablePartition(database, name, partition string) error {
+	query := fmt.Sprintf("ALTER TABLE %s DROP PARTITION %s", tableIdentifier(database, name), quoteIdentifier(partition))
+	_, err := c.db.Exec(query)
+	return err
+}
+
+func (c *Client) DropTable(database, name string) error {
+	_, err := c.db.Exec("DROP TABLE " + tableIdentifier(database, name))
+	return wrapNotFound(err, "table", name)
+}
+
+// listValues returns the known elements of a string list in order.
+func listValues(list types.List) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var values []string
+	for _, elem := range list.Elements() {
+		if v, ok := elem.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			values = append(values, v.ValueString())
+		}
+	}
+	return values
+}
+
+// stringList builds a list from values, or a null list when there are none.
+func stringList(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// mapValues returns the known entries of a string map.
+func mapValues(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]string, len(m.Elements()))
+	for k, elem := range m.Elements() {
+		if v, ok := elem.(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+			values[k] = v.ValueString()
+		}
+	}
+	return values
+}
+
+// stringMap builds a map from values, or a null map when there are none.
+func stringMap(values map[string]string) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elems := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// Kinds of asynchronous ALTER TABLE jobs. Column and sort key changes run
+// as schema change jobs, bucket changes as optimize jobs.
+const (
+	tableAlterColumn   = "COLUMN"
+	tableAlterOptimize = "OPTIMIZE"
+)
+
+// tableAlterPollInterval is how often WaitForTableAlter checks for running
+// schema change jobs.
+var tableAlterPollInterval = 2 * time.Second
+
+// latestTableAlterJob returns the latest ALTER TABLE job of the given kind
+// on a table, or nil if there is none.
+func (c *Client) latestTableAlterJob(database, name, kind string) (showRow, error) {
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SHOW ALTER TABLE %s FROM %s WHERE TableName = %s ORDER BY CreateTime DESC LIMIT 1",
+		kind, quoteIdentifier(database), quoteString(name),
+	))
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// LatestTableAlterJobID returns the ID of the latest ALTER TABLE job of the
+// given kind on a table, or "" if there is none. It is recorded before an
+// ALTER TABLE statement so that WaitForTableAlter can tell the job that
+// statement started from earlier ones.
+func (c *Client) LatestTableAlterJobID(database, name, kind string) (string, error) {
+	job, err := c.latestTableAlterJob(database, name, kind)
+	if err != nil {
+		return "", err
+	}
+	return job.get("jobid"), nil
+}
+
+// WaitForTableAlter blocks until the ALTER TABLE job of the given kind that
+// started after the job with ID since has finished. StarRocks runs these
+// jobs asynchronously and rejects further ALTER TABLE statements while one
+// is running. Statements that start no job return right away.
+func (c *Client) WaitForTableAlter(ctx context.Context, database, name, kind, since string) error {
+	for {
+		job, err := c.latestTableAlterJob(database, name, kind)
+		if err != nil {
+			return err
+		}
+		if job == nil || job.get("jobid") == since {
+			return nil
+		}
+
+		switch strings.ToUpper(job.get("state")) {
+		case "FINISHED":
+			return nil
+		case "CANCELLED":
+			return fmt.Errorf("alter job %s on table %s was cancelled: %s", job.get("jobid"), name, job.get("msg"))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tableAlterPollInterval):
+		}
+	}
+}
+
+func columnsFromList(list types.List) []TableColumn {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var columns []TableColumn
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		str := func(name string) types.String {
+			if v, ok := attrs[name].(types.String); ok {
+				return v
+			}
+			return types.StringNull()
+		}
+		nullable, ok := attrs["nullable"].(types.Bool)
+		if !ok {
+			nullable = types.BoolNull()
+		}
+		columns = append(columns, TableColumn{
+			Name:        str("name"),
+			Type:        str("type"),
+			Nullable:    nullable,
+			Default:     str("default"),
+			Comment:     str("comment"),
+			Aggregation: str("aggregation"),
+		})
+	}
+	return columns
+}
+
+func columnsToList(columns []TableColumn) types.List {
+	objType := types.ObjectType{AttrTypes: tableColumnAttrTypes}
+	elems := make([]attr.Value, len(columns))
+	for i, col := range columns {
+		elems[i] = types.ObjectValueMust(tableColumnAttrTypes, map[string]attr.Value{
+			"name":        col.Name,
+			"type":        col.Type,
+			"nullable":    col.Nullable,
+			"default":     col.Default,
+			"comment":     col.Comment,
+			"aggregation": col.Aggregation,
+		})
+	}
+	return types.ListValueMust(objType, elems)
+}
+
+func partitioningFromObject(obj types.Object) *TablePartitioning {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	p := &TablePartitioning{
+		Type:       types.StringNull(),
+		Columns:    types.ListNull(types.StringType),
+		Expression: types.StringNull(),
+	}
+	if v, ok := attrs["type"].(types.String); ok {
+		p.Type = v
+	}
+	if v, ok := attrs["columns"].(types.List); ok {
+		p.Columns = v
+	}
+	if v, ok := attrs["expression"].(types.String); ok {
+		p.Expression = v
+	}
+	if partitions, ok := attrs["partitions"].(types.List); ok {
+		p.Partitions = partitionsFromList(partitions)
+	}
+	return p
+}
+
+// partitionsFromList converts the partitions attribute of a partitioning
+// object.
+func partitionsFromList(list types.List) []TablePartition {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var partitions []TablePartition
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		part := TablePartition{Name: types.StringNull(), Values: types.ListNull(types.StringType)}
+		if v, ok := attrs["name"].(types.String); ok {
+			part.Name = v
+		}
+		if v, ok := attrs["values"].(types.List); ok {
+			part.Values = v
+		}
+		partitions = append(partitions, part)
+	}
+	return partitions
+}
+
+func partitioningToObject(p *TablePartitioning) types.Object {
+	if p == nil {
+		return types.ObjectNull(tablePartitioningAttrTypes)
+	}
+
+	partType := types.ObjectType{AttrTypes: tablePartitionAttrTypes}
+	partitions := types.ListNull(partType)
+	if len(p.Partitions) > 0 {
+		elems := make([]attr.Value, len(p.Partitions))
+		for i, part := range p.Partitions {
+			elems[i] = types.ObjectValueMust(tablePartitionAttrTypes, map[string]attr.Value{
+				"name":   part.Name,
+				"values": part.Values,
+			})
+		}
+		partitions = types.ListValueMust(partType, elems)
+	}
+
+	return types.ObjectValueMust(tablePartitioningAttrTypes, map[string]attr.Value{
+		"type":       p.Type,
+		"columns":    p.Columns,
+		"expression": p.Expression,
+		"partitions": partitions,
+	})
+}
+
+func distributionFromObject(obj types.Object) *TableDistribution {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	d := &TableDistribution{
+		Type:    types.StringNull(),
+		Columns: types.ListNull(types.StringType),
+		Buckets: types.Int64Null(),
+	}
+	if v, ok := attrs["type"].(types.String); ok {
+		d.Type = v
+	}
+	if v, ok := attrs["columns"].(types.List); ok {
+		d.Columns = v
+	}
+	if v, ok := attrs["buckets"].(types.Int64); ok {
+		d.Buckets = v
+	}
+	return d
+}
+
+func distributionToObject(d *TableDistribution) types.Object {
+	if d == nil {
+		return types.ObjectNull(tableDistributionAttrTypes)
+	}
+
+	return types.ObjectValueMust(tableDistributionAttrTypes, map[string]attr.Value{
+		"type":    d.Type,
+		"columns": d.Columns,
+		"buckets": d.Buckets,
+	})
+}
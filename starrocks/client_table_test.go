@@ -0,0 +1,535 @@
+package starrocks
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateTableStatement(t *testing.T) {
+	table := &Table{
+		Database: types.StringValue("analytics"),
+		Name:     types.StringValue("events"),
+		Comment:  types.StringValue("raw events"),
+		Columns: []TableColumn{
+			{Name: types.StringValue("dt"), Type: types.StringValue("DATE"), Nullable: types.BoolValue(false)},
+			{Name: types.StringValue("id"), Type: types.StringValue("BIGINT"), Nullable: types.BoolValue(false)},
+			{Name: types.StringValue("city"), Type: types.StringValue("VARCHAR(64)"), Nullable: types.BoolValue(true), Default: types.StringValue("unknown")},
+			{Name: types.StringValue("ts"), Type: types.StringValue("DATETIME"), Default: types.StringValue("CURRENT_TIMESTAMP"), Comment: types.StringValue("ingest time")},
+		},
+		KeyType:    types.StringValue(tableKeyDuplicate),
+		KeyColumns: stringList([]string{"dt", "id"}),
+		Partitioning: &TablePartitioning{
+			Type:       types.StringValue(partitionRange),
+			Columns:    stringList([]string{"dt"}),
+			Expression: types.StringNull(),
+			Partitions: []TablePartition{
+				{Name: types.StringValue("p2024"), Values: stringList([]string{"2025-01-01"})},
+				{Name: types.StringValue("pmax"), Values: stringList([]string{"MAXVALUE"})},
+			},
+		},
+		Distribution: &TableDistribution{
+			Type:    types.StringValue(distributionHash),
+			Columns: stringList([]string{"id"}),
+			Buckets: types.Int64Value(8),
+		},
+		OrderBy:    stringList([]string{"dt", "city"}),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{"replication_num": types.StringValue("1")}),
+	}
+
+	got, err := createTableStatement(table)
+	if err != nil {
+		t.Fatalf("createTableStatement failed: %v", err)
+	}
+	want := "CREATE TABLE `analytics`.`events` (\n" +
+		"  `dt` DATE NOT NULL,\n" +
+		"  `id` BIGINT NOT NULL,\n" +
+		"  `city` VARCHAR(64) NULL DEFAULT 'unknown',\n" +
+		"  `ts` DATETIME NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'ingest time'\n" +
+		") ENGINE=OLAP\n" +
+		"DUPLICATE KEY (`dt`, `id`)\n" +
+		"COMMENT 'raw events'\n" +
+		"PARTITION BY RANGE(`dt`) (PARTITION `p2024` VALUES LESS THAN ('2025-01-01'), PARTITION `pmax` VALUES LESS THAN MAXVALUE)\n" +
+		"DISTRIBUTED BY HASH(`id`) BUCKETS 8\n" +
+		"ORDER BY (`dt`, `city`) PROPERTIES ('replication_num' = '1')"
+	if got != want {
+		t.Errorf("createTableStatement =\n%s\nwant\n%s", got, want)
+	}
+
+	table.Columns[0].Type = types.StringValue("DATE NOT NULL, x INT")
+	if _, err := createTableStatement(table); err == nil {
+		t.Error("expected an error for an invalid column type")
+	}
+}
+
+func TestPartitionClause(t *testing.T) {
+	tests := []struct {
+		p    *TablePartitioning
+		want string
+	}{
+		{
+			p: &TablePartitioning{
+				Type:       types.StringValue(partitionExpression),
+				Columns:    types.ListNull(types.StringType),
+				Expression: types.StringValue("date_trunc('day', dt)"),
+			},
+			want: "PARTITION BY date_trunc('day', dt)",
+		},
+		{
+			p: &TablePartitioning{
+				Type:       types.StringValue(partitionExpression),
+				Columns:    stringList([]string{"dt", "city"}),
+				Expression: types.StringNull(),
+			},
+			want: "PARTITION BY `dt`, `city`",
+		},
+		{
+			p: &TablePartitioning{
+				Type:    types.StringValue(partitionList),
+				Columns: stringList([]string{"city"}),
+				Partitions: []TablePartition{
+					{Name: types.StringValue("p_east"), Values: stringList([]string{"Boston", "New York"})},
+				},
+			},
+			want: "PARTITION BY LIST(`city`) (PARTITION `p_east` VALUES IN ('Boston', 'New York'))",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := partitionClause(tt.p)
+		if err != nil {
+			t.Fatalf("partitionClause failed: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("partitionClause = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+const testCreateTableDDL = "CREATE TABLE `events` (\n" +
+	"  `dt` date NOT NULL COMMENT \"\",\n" +
+	"  `city` varchar(64) NULL COMMENT \"\",\n" +
+	"  `pv` bigint(20) SUM NULL COMMENT \"page views\",\n" +
+	"  `last_seen` datetime REPLACE NULL COMMENT \"\"\n" +
+	") ENGINE=OLAP \n" +
+	"AGGREGATE KEY(`dt`, `city`)\n" +
+	"COMMENT \"daily events\"\n" +
+	"PARTITION BY RANGE(`dt`)\n" +
+	"(PARTITION p2024 VALUES [(\"0000-01-01\"), (\"2025-01-01\")),\n" +
+	"PARTITION p2025 VALUES [(\"2025-01-01\"), (\"2026-01-01\")))\n" +
+	"DISTRIBUTED BY HASH(`city`) BUCKETS 4 \n" +
+	"ORDER BY(`city`, `dt`)\n" +
+	"PROPERTIES (\n" +
+	"\"compression\" = \"LZ4\",\n" +
+	"\"replication_num\" = \"1\"\n" +
+	");"
+
+func TestParseCreateTable(t *testing.T) {
+	got := parseCreateTable(testCreateTableDDL)
+
+	if got.KeyType.ValueString() != tableKeyAggregate {
+		t.Errorf("KeyType = %v, want %s", got.KeyType, tableKeyAggregate)
+	}
+	if keys := listValues(got.KeyColumns); !reflect.DeepEqual(keys, []string{"dt", "city"}) {
+		t.Errorf("KeyColumns = %v", keys)
+	}
+	if got.Comment.ValueString() != "daily events" {
+		t.Errorf("Comment = %v, want daily events", got.Comment)
+	}
+	if order := listValues(got.OrderBy); !reflect.DeepEqual(order, []string{"city", "dt"}) {
+		t.Errorf("OrderBy = %v", order)
+	}
+
+	d := got.Distribution
+	if d == nil || d.Type.ValueString() != distributionHash || d.Buckets.ValueInt64() != 4 || !reflect.DeepEqual(listValues(d.Columns), []string{"city"}) {
+		t.Errorf("Distribution = %+v", d)
+	}
+
+	p := got.Partitioning
+	if p == nil || p.Type.ValueString() != partitionRange || !reflect.DeepEqual(listValues(p.Columns), []string{"dt"}) {
+		t.Fatalf("Partitioning = %+v", p)
+	}
+	if len(p.Partitions) != 2 {
+		t.Fatalf("got %d partitions, want 2", len(p.Partitions))
+	}
+	if p.Partitions[1].Name.ValueString() != "p2025" || !reflect.DeepEqual(listValues(p.Partitions[1].Values), []string{"2026-01-01"}) {
+		t.Errorf("Partitions[1] = %+v", p.Partitions[1])
+	}
+
+	props := mapValues(got.Properties)
+	if !reflect.DeepEqual(props, map[string]string{"compression": "LZ4", "replication_num": "1"}) {
+		t.Errorf("Properties = %v", props)
+	}
+
+	aggregations := parseColumnAggregations(testCreateTableDDL)
+	if !reflect.DeepEqual(aggregations, map[string]string{"pv": "SUM", "last_seen": "REPLACE"}) {
+		t.Errorf("parseColumnAggregations = %v", aggregations)
+	}
+}
+
+func TestParseCreateTableRandom(t *testing.T) {
+	got := parseCreateTable("CREATE TABLE `logs` (\n  `msg` varchar(1024) NULL COMMENT \"\"\n) ENGINE=OLAP \n" +
+		"DUPLICATE KEY(`msg`)\nPARTITION BY date_trunc('day', `ts`)\nDISTRIBUTED BY RANDOM\nPROPERTIES (\n\"replication_num\" = \"1\"\n);")
+
+	if !got.Comment.IsNull() {
+		t.Errorf("Comment = %v, want null", got.Comment)
+	}
+	if d := got.Distribution; d == nil || d.Type.ValueString() != distributionRandom || !d.Columns.IsNull() || !d.Buckets.IsNull() {
+		t.Errorf("Distribution = %+v", d)
+	}
+	if p := got.Partitioning; p == nil || p.Type.ValueString() != partitionExpression || p.Expression.ValueString() != "date_trunc('day', `ts`)" {
+		t.Errorf("Partitioning = %+v", p)
+	}
+}
+
+func TestNormalizeColumnType(t *testing.T) {
+	tests := map[string]string{
+		"int(11)":          "INT",
+		"INTEGER":          "INT",
+		"bigint(20)":       "BIGINT",
+		"string":           "VARCHAR(65533)",
+		"varchar(64)":      "VARCHAR(64)",
+		"decimal64(10, 2)": "DECIMAL(10,2)",
+		"DECIMAL128(38,9)": "DECIMAL(38,9)",
+		"ARRAY<INT>":       "ARRAY<INT>",
+		"bool":             "BOOLEAN",
+	}
+
+	for in, want := range tests {
+		if got := normalizeColumnType(in); got != want {
+			t.Errorf("normalizeColumnType(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	// Nested types compare equal to the form information_schema reports.
+	equivalent := [][2]string{
+		{"ARRAY<INT>", "array<int(11)>"},
+		{"ARRAY<ARRAY<STRING>>", "array<array<varchar(65533)>>"},
+		{"MAP<STRING, BIGINT>", "map<varchar(65533),bigint(20)>"},
+		{"MAP<INT, ARRAY<DECIMAL64(10, 2)>>", "map<int(11),array<decimal(10,2)>>"},
+		{"STRUCT<id INT, name STRING>", "struct<id int(11), name varchar(65533)>"},
+		{"ARRAY<STRUCT<a INTEGER, b BOOL>>", "array<struct<a int(11), b boolean>>"},
+	}
+	for _, pair := range equivalent {
+		if a, b := normalizeColumnType(pair[0]), normalizeColumnType(pair[1]); a != b {
+			t.Errorf("normalizeColumnType(%q) = %q, normalizeColumnType(%q) = %q, want equal", pair[0], a, pair[1], b)
+		}
+	}
+	if normalizeColumnType("ARRAY<INT>") == normalizeColumnType("ARRAY<BIGINT>") {
+		t.Error("ARRAY<INT> and ARRAY<BIGINT> should differ")
+	}
+	if normalizeColumnType("STRUCT<a INT>") == normalizeColumnType("STRUCT<b INT>") {
+		t.Error("struct field names should be compared")
+	}
+}
+
+func TestMergePartitioning(t *testing.T) {
+	partition := func(name string, values ...string) TablePartition {
+		return TablePartition{Name: types.StringValue(name), Values: stringList(values)}
+	}
+
+	prior := &TablePartitioning{
+		Type:       types.StringValue("range"),
+		Columns:    stringList([]string{"DT"}),
+		Expression: types.StringNull(),
+		Partitions: []TablePartition{
+			partition("p202402", "2024-03-01"),
+			partition("p202401", "2024-02-01"),
+			partition("p202312", "2024-01-01"),
+		},
+	}
+	current := &TablePartitioning{
+		Type:       types.StringValue(partitionRange),
+		Columns:    stringList([]string{"dt"}),
+		Expression: types.StringNull(),
+		Partitions: []TablePartition{
+			partition("p202401", "2024-02-01 00:00:00"),
+			partition("p202402", "2024-03-15 00:00:00"),
+			partition("p202403", "2024-04-01 00:00:00"),
+		},
+	}
+
+	got := mergePartitioning(prior, current)
+	if got.Type.ValueString() != "range" || !got.Columns.Equal(prior.Columns) {
+		t.Errorf("mergePartitioning() type/columns = %v/%v, want configured spelling", got.Type, got.Columns)
+	}
+
+	// p202312 was dropped, p202402 changed and p202403 was added outside
+	// Terraform; equivalent values keep their configured spelling.
+	want := []TablePartition{
+		partition("p202402", "2024-03-15 00:00:00"),
+		partition("p202401", "2024-02-01"),
+		partition("p202403", "2024-04-01 00:00:00"),
+	}
+	if !reflect.DeepEqual(got.Partitions, want) {
+		t.Errorf("mergePartitioning() partitions = %+v, want %+v", got.Partitions, want)
+	}
+
+	expr := &TablePartitioning{
+		Type:       types.StringValue(partitionExpression),
+		Columns:    types.ListNull(types.StringType),
+		Expression: types.StringValue("date_trunc('day', dt)"),
+	}
+	serverExpr := *expr
+	serverExpr.Expression = types.StringValue("date_trunc('day', `dt`)")
+	if got := mergePartitioning(expr, &serverExpr); got.Expression.ValueString() != "date_trunc('day', dt)" {
+		t.Errorf("mergePartitioning() expression = %v, want configured spelling", got.Expression)
+	}
+}
+
+func TestGetTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE TABLE `analytics`.`events`")).WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("events", testCreateTableDDL),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT COLUMN_NAME, COLUMN_TYPE, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_COMMENT FROM information_schema.columns " +
+			"WHERE TABLE_SCHEMA = 'analytics' AND TABLE_NAME = 'events' ORDER BY ORDINAL_POSITION",
+	)).WillReturnRows(
+		sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_TYPE", "IS_NULLABLE", "COLUMN_DEFAULT", "COLUMN_COMMENT"}).
+			AddRow("dt", "date", "NO", nil, "").
+			AddRow("city", "varchar(64)", "YES", nil, "").
+			AddRow("pv", "bigint(20)", "YES", "0", "page views").
+			AddRow("last_seen", "datetime", "YES", nil, ""),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW CREATE TABLE `analytics`.`missing`")).
+		WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}))
+
+	got, err := client.GetTable("analytics", "events")
+	if err != nil {
+		t.Fatalf("GetTable failed: %v", err)
+	}
+
+	if len(got.Columns) != 4 {
+		t.Fatalf("got %d columns, want 4", len(got.Columns))
+	}
+	if dt := got.Columns[0]; dt.Nullable.ValueBool() || !dt.Default.IsNull() || !dt.Aggregation.IsNull() {
+		t.Errorf("Columns[0] = %+v", dt)
+	}
+	pv := got.Columns[2]
+	if pv.Default.ValueString() != "0" || pv.Comment.ValueString() != "page views" || pv.Aggregation.ValueString() != "SUM" {
+		t.Errorf("Columns[2] = %+v", pv)
+	}
+
+	if _, err := client.GetTable("analytics", "missing"); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestAddColumnPosition(t *testing.T) {
+	columns := []TableColumn{
+		{Name: types.StringValue("id")},
+		{Name: types.StringValue("dt")},
+		{Name: types.StringValue("city")},
+	}
+
+	tests := []struct {
+		i         int
+		wantFirst bool
+		wantAfter string
+	}{
+		{0, true, ""},
+		{1, false, "id"},
+		{2, false, ""},
+	}
+	for _, tt := range tests {
+		first, after := addColumnPosition(columns, tt.i)
+		if first != tt.wantFirst || after != tt.wantAfter {
+			t.Errorf("addColumnPosition(%d) = %v, %q, want %v, %q", tt.i, first, after, tt.wantFirst, tt.wantAfter)
+		}
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` ADD COLUMN `uv` BIGINT NULL AFTER `city`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` ADD COLUMN `id` BIGINT NULL FIRST")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` MODIFY COLUMN `city` VARCHAR(128) NOT NULL")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` DROP COLUMN `uv`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` SET ('compression' = 'ZSTD')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` SET ('replication_num' = '3')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` DISTRIBUTED BY HASH(`city`) BUCKETS 16")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` ADD PARTITION `p2026` VALUES LESS THAN ('2027-01-01')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `analytics`.`events` DROP PARTITION `p2024`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.AddTableColumn("analytics", "events", TableColumn{Name: types.StringValue("uv"), Type: types.StringValue("BIGINT")}, false, "city"); err != nil {
+		t.Fatalf("AddTableColumn failed: %v", err)
+	}
+	if err := client.AddTableColumn("analytics", "events", TableColumn{Name: types.StringValue("id"), Type: types.StringValue("BIGINT")}, true, ""); err != nil {
+		t.Fatalf("AddTableColumn failed: %v", err)
+	}
+	if err := client.ModifyTableColumn("analytics", "events", TableColumn{Name: types.StringValue("city"), Type: types.StringValue("VARCHAR(128)"), Nullable: types.BoolValue(false)}); err != nil {
+		t.Fatalf("ModifyTableColumn failed: %v", err)
+	}
+	if err := client.DropTableColumn("analytics", "events", "uv"); err != nil {
+		t.Fatalf("DropTableColumn failed: %v", err)
+	}
+	if err := client.SetTableProperties("analytics", "events", map[string]string{"replication_num": "3", "compression": "ZSTD"}); err != nil {
+		t.Fatalf("SetTableProperties failed: %v", err)
+	}
+	err = client.SetTableDistribution("analytics", "events", &TableDistribution{
+		Type:    types.StringValue(distributionHash),
+		Columns: stringList([]string{"city"}),
+		Buckets: types.Int64Value(16),
+	})
+	if err != nil {
+		t.Fatalf("SetTableDistribution failed: %v", err)
+	}
+	err = client.AddTablePartition("analytics", "events", partitionRange, TablePartition{
+		Name:   types.StringValue("p2026"),
+		Values: stringList([]string{"2027-01-01"}),
+	})
+	if err != nil {
+		t.Fatalf("AddTablePartition failed: %v", err)
+	}
+	if err := client.DropTablePartition("analytics", "events", "p2024"); err != nil {
+		t.Fatalf("DropTablePartition failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestWaitForTableAlter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+	defer func(interval time.Duration) { tableAlterPollInterval = interval }(tableAlterPollInterval)
+	tableAlterPollInterval = time.Millisecond
+
+	cols := []string{"JobId", "TableName", "CreateTime", "State", "Msg"}
+	columnJobs := regexp.QuoteMeta("SHOW ALTER TABLE COLUMN FROM `analytics` WHERE TableName = 'events' ORDER BY CreateTime DESC LIMIT 1")
+	mock.ExpectQuery(columnJobs).WillReturnRows(sqlmock.NewRows(cols).AddRow("1", "events", "2024-01-01 00:00:00", "CANCELLED", "old failure"))
+	mock.ExpectQuery(columnJobs).WillReturnRows(sqlmock.NewRows(cols).AddRow("2", "events", "2024-01-02 00:00:00", "RUNNING", ""))
+	mock.ExpectQuery(columnJobs).WillReturnRows(sqlmock.NewRows(cols).AddRow("2", "events", "2024-01-02 00:00:00", "FINISHED", ""))
+	mock.ExpectQuery(columnJobs).WillReturnRows(sqlmock.NewRows(cols).AddRow("2", "events", "2024-01-02 00:00:00", "FINISHED", ""))
+	mock.ExpectQuery(columnJobs).WillReturnRows(sqlmock.NewRows(cols).AddRow("3", "events", "2024-01-03 00:00:00", "CANCELLED", "type change not supported"))
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW ALTER TABLE OPTIMIZE FROM `analytics` WHERE TableName = 'events' ORDER BY CreateTime DESC LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows(cols))
+
+	// An earlier cancelled job does not affect later changes.
+	since, err := client.LatestTableAlterJobID("analytics", "events", tableAlterColumn)
+	if err != nil || since != "1" {
+		t.Fatalf("LatestTableAlterJobID = %q, %v, want 1", since, err)
+	}
+	if err := client.WaitForTableAlter(context.Background(), "analytics", "events", tableAlterColumn, since); err != nil {
+		t.Fatalf("WaitForTableAlter failed: %v", err)
+	}
+
+	// A statement that started no job does not wait.
+	if err := client.WaitForTableAlter(context.Background(), "analytics", "events", tableAlterColumn, "2"); err != nil {
+		t.Fatalf("WaitForTableAlter failed: %v", err)
+	}
+
+	if err := client.WaitForTableAlter(context.Background(), "analytics", "events", tableAlterColumn, "2"); err == nil {
+		t.Error("expected an error for a cancelled job")
+	}
+
+	if err := client.WaitForTableAlter(context.Background(), "analytics", "events", tableAlterOptimize, ""); err != nil {
+		t.Fatalf("WaitForTableAlter failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestRequiresReplacePartitions(t *testing.T) {
+	partitions := func(parts ...TablePartition) types.List {
+		obj := partitioningToObject(&TablePartitioning{
+			Type:       types.StringValue(partitionRange),
+			Columns:    stringList([]string{"dt"}),
+			Expression: types.StringNull(),
+			Partitions: parts,
+		})
+		return obj.Attributes()["partitions"].(types.List)
+	}
+	partition := func(name string, values ...string) TablePartition {
+		return TablePartition{Name: types.StringValue(name), Values: stringList(values)}
+	}
+	state := partitions(partition("p2025", "2026-01-01"), partition("p2026", "2027-01-01"))
+
+	tests := []struct {
+		name string
+		plan types.List
+		want bool
+	}{
+		{"added", partitions(partition("p2025", "2026-01-01"), partition("p2026", "2027-01-01"), partition("p2027", "2028-01-01")), false},
+		{"dropped", partitions(partition("p2026", "2027-01-01")), false},
+		{"same values", partitions(partition("p2025", "2026-01-01 00:00:00"), partition("p2026", "2027-01-01")), false},
+		{"changed values", partitions(partition("p2025", "2026-02-01"), partition("p2026", "2027-01-01")), true},
+	}
+
+	for _, tt := range tests {
+		req := planmodifier.ListRequest{StateValue: state, PlanValue: tt.plan}
+		var resp listplanmodifier.RequiresReplaceIfFuncResponse
+		requiresReplacePartitions(context.Background(), req, &resp)
+		if resp.RequiresReplace != tt.want {
+			t.Errorf("%s: RequiresReplace = %v, want %v", tt.name, resp.RequiresReplace, tt.want)
+		}
+	}
+}
+
+func TestUntrackedPropertiesWarning(t *testing.T) {
+	state := stringMap(map[string]string{"replication_num": "3", "storage_medium": "SSD"})
+
+	tests := []struct {
+		name string
+		plan types.Map
+		want bool
+	}{
+		{"unchanged", state, false},
+		{"changed", stringMap(map[string]string{"replication_num": "1", "storage_medium": "SSD"}), false},
+		{"removed", stringMap(map[string]string{"replication_num": "3"}), true},
+		{"all removed", types.MapNull(types.StringType), true},
+	}
+
+	for _, tt := range tests {
+		req := planmodifier.MapRequest{StateValue: state, PlanValue: tt.plan}
+		var resp planmodifier.MapResponse
+		untrackedPropertiesWarning{}.PlanModifyMap(context.Background(), req, &resp)
+		if got := resp.Diagnostics.WarningsCount() > 0; got != tt.want {
+			t.Errorf("%s: warning = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package starrocks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TaskSchedule struct {
+	Start    types.String
+	Interval types.String
+}
+
+type Task struct {
+	Database   types.String
+	Name       types.String
+	Query      types.String
+	Schedule   *TaskSchedule
+	Properties types.Map
+}
+
+// TaskRun is the latest run of a task in information_schema.task_runs.
+type TaskRun struct {
+	State        types.String
+	CreateTime   types.String
+	FinishTime   types.String
+	ErrorMessage types.String
+}
+
+var taskScheduleAttrTypes = map[string]attr.Type{
+	"start":    types.StringType,
+	"interval": types.StringType,
+}
+
+func submitTaskStatement(t *Task) string {
+	var b strings.Builder
+	b.WriteString("SUBMIT TASK ")
+	b.WriteString(tableIdentifier(t.Database.ValueString(), t.Name.ValueString()))
+	if s := t.Schedule; s != nil {
+		b.WriteString("\nSCHEDULE ")
+		if start := s.Start; !start.IsNull() && !start.IsUnknown() {
+			b.WriteString("START(" + quoteString(start.ValueString()) + ") ")
+		}
+		b.WriteString("EVERY(INTERVAL " + s.Interval.ValueString() + ")")
+	}
+	if props := mapValues(t.Properties); len(props) > 0 {
+		b.WriteString("\nPROPERTIES (" + quotePropertyList(props) + ")")
+	}
+	b.WriteString("\nAS " + viewQuery(t.Query.ValueString()))
+	return b.String()
+}
+
+// SubmitTask creates a task. Tasks without a schedule run once, right away.
+func (c *Client) SubmitTask(t *Task) error {
+	_, err := c.db.Exec(submitTaskStatement(t))
+	return err
+}
+
+var (
+	taskDefinitionPattern = regexp.MustCompile(`(?is)^\s*SUBMIT\b.*?\bAS\s+((?:INSERT|CREATE|SELECT|WITH)\b.*)$`)
+	taskIntervalPattern   = regexp.MustCompile(`(?i)\bEVERY\s*\(\s*(?:INTERVAL\s+)?(\d+)\s*([A-Z]+)\s*\)`)
+)
+
+// GetTask reads a task from information_schema.tasks.
+func (c *Client) GetTask(database, name string) (*Task, error) {
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SELECT TASK_NAME, `DATABASE`, SCHEDULE, DEFINITION, PROPERTIES FROM information_schema.tasks WHERE TASK_NAME = %s",
+		quoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		// Older releases prefix database names with "default_cluster:".
+		db := row.get("database")
+		if i := strings.LastIndex(db, ":"); i >= 0 {
+			db = db[i+1:]
+		}
+		if db != database {
+			continue
+		}
+
+		t := &Task{
+			Database:   types.StringValue(database),
+			Name:       types.StringValue(name),
+			Query:      types.StringValue(strings.TrimSpace(row.get("definition"))),
+			Schedule:   parseTaskSchedule(row.get("schedule")),
+			Properties: stringMap(parseTaskProperties(row.get("properties"))),
+		}
+		if m := taskDefinitionPattern.FindStringSubmatch(row.get("definition")); m != nil {
+			t.Query = types.StringValue(strings.TrimSpace(m[1]))
+		}
+		return t, nil
+	}
+
+	return nil, &NotFoundError{Kind: "task", Name: name}
+}
+
+// parseTaskSchedule parses the SCHEDULE column of information_schema.tasks,
+// e.g. "PERIODICAL START(2024-01-01T00:00) EVERY(1 HOURS)". Manual tasks
+// have no schedule. The start time is not parsed since its format differs
+// from the one used to submit the task.
+func parseTaskSchedule(s string) *TaskSchedule {
+	m := taskIntervalPattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+	unit := strings.TrimSuffix(strings.ToUpper(m[2]), "S")
+	return &TaskSchedule{
+		Start:    types.StringNull(),
+		Interval: types.StringValue(m[1] + " " + unit),
+	}
+}
+
+// parseTaskProperties parses the PROPERTIES column of information_schema.tasks,
+// which is either a JSON object or a list of "key" = "value" pairs.
+func parseTaskProperties(s string) map[string]string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "{") {
+		if props, err := parseJSONProperties(s); err == nil {
+			return props
+		}
+	}
+	return parseProperties("PROPERTIES (" + strings.Trim(s, "()") + ")")
+}
+
+// GetLastTaskRun returns the latest run of a task, or nil if it has not run.
+func (c *Client) GetLastTaskRun(name string) (*TaskRun, error) {
+	rows, err := c.queryRows(fmt.Sprintf(
+		"SELECT STATE, CREATE_TIME, FINISH_TIME, ERROR_MESSAGE FROM information_schema.task_runs "+
+			"WHERE TASK_NAME = %s ORDER BY CREATE_TIME DESC LIMIT 1",
+		quoteString(name),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &TaskRun{
+		State:        parseStringColumn(rows[0].get("state")),
+		CreateTime:   parseStringColumn(rows[0].get("create_time")),
+		FinishTime:   parseStringColumn(rows[0].get("finish_time")),
+		ErrorMessage: parseStringColumn(rows[0].get("error_message")),
+	}, nil
+}
+
+// DropTask drops a task. Task names are unique across databases.
+func (c *Client) DropTask(name string) error {
+	_, err := c.db.Exec("DROP TASK " + quoteIdentifier(name))
+	return wrapNotFound(err, "task", name)
+}
+
+func taskScheduleFromObject(obj types.Object) *TaskSchedule {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+
+	attrs := obj.Attributes()
+	s := &TaskSchedule{
+		Start:    types.StringNull(),
+		Interval: types.StringNull(),
+	}
+	if v, ok := attrs["start"].(types.String); ok {
+		s.Start = v
+	}
+	if v, ok := attrs["interval"].(types.String); ok {
+		s.Interval = v
+	}
+	return s
+}
+
+func taskScheduleToObject(s *TaskSchedule) types.Object {
+	if s == nil {
+		return types.ObjectNull(taskScheduleAttrTypes)
+	}
+
+	return types.ObjectValueMust(taskScheduleAttrTypes, map[string]attr.Value{
+		"start":    s.Start,
+		"interval": s.Interval,
+	})
+}
@@ -0,0 +1,139 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSubmitTaskStatement(t *testing.T) {
+	task := &Task{
+		Database: types.StringValue("analytics"),
+		Name:     types.StringValue("daily_rollup"),
+		Query:    types.StringValue("INSERT OVERWRITE daily SELECT dt, count(*) FROM orders GROUP BY dt;"),
+		Schedule: &TaskSchedule{
+			Start:    types.StringValue("2024-01-01 02:00:00"),
+			Interval: types.StringValue("1 DAY"),
+		},
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"query_timeout": types.StringValue("3600"),
+		}),
+	}
+
+	want := "SUBMIT TASK `analytics`.`daily_rollup`\n" +
+		"SCHEDULE START('2024-01-01 02:00:00') EVERY(INTERVAL 1 DAY)\n" +
+		"PROPERTIES ('query_timeout' = '3600')\n" +
+		"AS INSERT OVERWRITE daily SELECT dt, count(*) FROM orders GROUP BY dt"
+	if got := submitTaskStatement(task); got != want {
+		t.Errorf("submitTaskStatement() =\n%s\nwant\n%s", got, want)
+	}
+
+	task.Schedule, task.Properties = nil, types.MapNull(types.StringType)
+	want = "SUBMIT TASK `analytics`.`daily_rollup`\nAS INSERT OVERWRITE daily SELECT dt, count(*) FROM orders GROUP BY dt"
+	if got := submitTaskStatement(task); got != want {
+		t.Errorf("submitTaskStatement() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParseTaskSchedule(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *TaskSchedule
+	}{
+		{"MANUAL", nil},
+		{"", nil},
+		{"PERIODICAL START(2024-01-01T02:00) EVERY(1 DAYS)", &TaskSchedule{Start: types.StringNull(), Interval: types.StringValue("1 DAY")}},
+		{"PERIODICAL (START 2024-01-01T02:00 EVERY(30 MINUTES))", &TaskSchedule{Start: types.StringNull(), Interval: types.StringValue("30 MINUTE")}},
+	}
+
+	for _, tt := range tests {
+		if got := parseTaskSchedule(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTaskSchedule(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseTaskProperties(t *testing.T) {
+	want := map[string]string{"query_timeout": "3600", "warehouse": "etl"}
+	for _, in := range []string{
+		`{"query_timeout":"3600","warehouse":"etl"}`,
+		`("query_timeout" = "3600", "warehouse" = "etl")`,
+	} {
+		if got := parseTaskProperties(in); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseTaskProperties(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestGetTask(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	cols := []string{"TASK_NAME", "DATABASE", "SCHEDULE", "DEFINITION", "PROPERTIES"}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT TASK_NAME, `DATABASE`, SCHEDULE, DEFINITION, PROPERTIES FROM information_schema.tasks WHERE TASK_NAME = 'daily_rollup'")).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(
+			"daily_rollup", "default_cluster:analytics", "PERIODICAL START(2024-01-01T02:00) EVERY(1 DAYS)",
+			"submit task daily_rollup schedule every(interval 1 day) as insert overwrite daily select dt, count(*) from orders group by dt",
+			`{"query_timeout":"3600"}`,
+		))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT STATE, CREATE_TIME, FINISH_TIME, ERROR_MESSAGE FROM information_schema.task_runs WHERE TASK_NAME = 'daily_rollup' ORDER BY CREATE_TIME DESC LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"STATE", "CREATE_TIME", "FINISH_TIME", "ERROR_MESSAGE"}).
+			AddRow("FAILED", "2024-01-02 02:00:00", "2024-01-02 02:00:05", "Table not found"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.tasks WHERE TASK_NAME = 'other_db'")).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow("other_db", "sales", "MANUAL", "INSERT INTO t SELECT 1", ""))
+
+	got, err := client.GetTask("analytics", "daily_rollup")
+	if err != nil {
+		t.Fatalf("GetTask failed: %v", err)
+	}
+	if got.Query.ValueString() != "insert overwrite daily select dt, count(*) from orders group by dt" {
+		t.Errorf("Query = %v", got.Query)
+	}
+	if got.Schedule == nil || got.Schedule.Interval.ValueString() != "1 DAY" {
+		t.Errorf("Schedule = %+v", got.Schedule)
+	}
+	if props := mapValues(got.Properties); !reflect.DeepEqual(props, map[string]string{"query_timeout": "3600"}) {
+		t.Errorf("Properties = %v", props)
+	}
+
+	run, err := client.GetLastTaskRun("daily_rollup")
+	if err != nil {
+		t.Fatalf("GetLastTaskRun failed: %v", err)
+	}
+	if run.State.ValueString() != "FAILED" || run.ErrorMessage.ValueString() != "Table not found" {
+		t.Errorf("GetLastTaskRun = %+v", run)
+	}
+
+	// The configured start time is kept.
+	model := taskResourceModel{
+		Schedule: taskScheduleToObject(&TaskSchedule{
+			Start:    types.StringValue("2024-01-01 02:00:00"),
+			Interval: types.StringValue("1 day"),
+		}),
+		Properties: types.MapNull(types.StringType),
+	}
+	if diags := model.refresh(got); diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	schedule := taskScheduleFromObject(model.Schedule)
+	if schedule.Start.ValueString() != "2024-01-01 02:00:00" || schedule.Interval.ValueString() != "1 day" {
+		t.Errorf("Schedule = %+v", schedule)
+	}
+
+	if _, err := client.GetTask("analytics", "other_db"); !IsNotFound(err) {
+		t.Errorf("GetTask(other_db) error = %v, want not found", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
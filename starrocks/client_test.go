@@ -1,9 +1,14 @@
 package starrocks
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,7 +23,7 @@ func TestCreateResourceGroupQuery(t *testing.T) {
 			model: &resourceGroupResourceModel{
 				Name:             types.StringValue("rg_test"),
 				CPUWeight:        types.Int64Value(1),
-				MemLimit:         types.StringValue("80%"),
+				MemLimit:         NewPercentageValue("80%"),
 				ConcurrencyLimit: types.Int64Value(10),
 			},
 			contains: []string{
@@ -33,7 +38,7 @@ func TestCreateResourceGroupQuery(t *testing.T) {
 			model: &resourceGroupResourceModel{
 				Name:                   types.StringValue("rg_full"),
 				CPUWeight:              types.Int64Value(5),
-				MemLimit:               types.StringValue("50%"),
+				MemLimit:               NewPercentageValue("50%"),
 				ConcurrencyLimit:       types.Int64Value(20),
 				BigQueryMemLimit:       types.Int64Value(2147483648),
 				BigQueryScanRowsLimit:  types.Int64Value(200000),
@@ -62,6 +67,35 @@ func TestCreateResourceGroupQuery(t *testing.T) {
 	}
 }
 
+func TestCreateResourceGroup_HostileInput(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	model := &resourceGroupResourceModel{
+		Name:     types.StringValue("rg-with`tick"),
+		MemLimit: NewPercentageValue("80%"),
+		Classifiers: classifiersToList([]Classifier{
+			{User: types.StringValue("x'); DROP USER root; --")},
+		}),
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE RESOURCE GROUP `rg-with``tick` TO (user='x\\'); DROP USER root; --') WITH ('mem_limit' = '80%')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.CreateResourceGroup(model); err != nil {
+		t.Fatalf("CreateResourceGroup failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestGetResourceGroup_12Columns(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -76,7 +110,7 @@ func TestGetResourceGroup_12Columns(t *testing.T) {
 		"big_query_cpu_second_limit", "big_query_scan_rows_limit", "big_query_mem_limit",
 		"concurrency_limit", "spill_mem_limit_threshold", "warehouses", "classifiers"}
 
-	mock.ExpectQuery("SHOW RESOURCE GROUP test_rg").WillReturnRows(
+	mock.ExpectQuery("SHOW RESOURCE GROUP `test_rg`").WillReturnRows(
 		sqlmock.NewRows(cols).AddRow(
 			"test_rg", "1", "10", "0", "80.0%",
 			"100", "500000", "1073741824",
@@ -107,6 +141,21 @@ func TestGetResourceGroup_12Columns(t *testing.T) {
 	if rg.BigQueryCPUSecondLimit.ValueInt64() != 100 {
 		t.Errorf("BigQueryCPUSecondLimit = %d, want 100", rg.BigQueryCPUSecondLimit.ValueInt64())
 	}
+	if rg.CPUWeight.ValueInt64() != 10 {
+		t.Errorf("CPUWeight = %d, want 10", rg.CPUWeight.ValueInt64())
+	}
+	if !rg.ExclusiveCPUCores.IsNull() {
+		t.Errorf("ExclusiveCPUCores = %v, want null", rg.ExclusiveCPUCores)
+	}
+	if rg.SpillMemLimitThreshold.ValueString() != "80%" {
+		t.Errorf("SpillMemLimitThreshold = %q, want %q", rg.SpillMemLimitThreshold.ValueString(), "80%")
+	}
+	if !rg.Warehouses.IsNull() {
+		t.Errorf("Warehouses = %v, want null", rg.Warehouses)
+	}
+	if len(rg.Classifiers.Elements()) != 1 {
+		t.Errorf("Classifiers has %d elements, want 1", len(rg.Classifiers.Elements()))
+	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("unfulfilled expectations: %v", err)
@@ -127,7 +176,7 @@ func TestGetResourceGroup_11Columns(t *testing.T) {
 		"big_query_cpu_second_limit", "big_query_scan_rows_limit", "big_query_mem_limit",
 		"concurrency_limit", "spill_mem_limit_threshold", "classifiers"}
 
-	mock.ExpectQuery("SHOW RESOURCE GROUP test_rg").WillReturnRows(
+	mock.ExpectQuery("SHOW RESOURCE GROUP `test_rg`").WillReturnRows(
 		sqlmock.NewRows(cols).AddRow(
 			"test_rg", "1", "10", "0", "80.0%",
 			"100", "500000", "1073741824",
@@ -149,6 +198,81 @@ func TestGetResourceGroup_11Columns(t *testing.T) {
 	}
 }
 
+func TestGetResourceGroup_LegacyColumns(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	// StarRocks before 3.3 reports cpu_core_limit and max_cpu_cores
+	cols := []string{"name", "id", "cpu_core_limit", "mem_limit", "max_cpu_cores",
+		"big_query_cpu_second_limit", "big_query_scan_rows_limit", "big_query_mem_limit",
+		"concurrency_limit", "warehouses", "classifiers"}
+
+	mock.ExpectQuery("SHOW RESOURCE GROUP `test_rg`").WillReturnRows(
+		sqlmock.NewRows(cols).
+			AddRow("test_rg", "7", "4", "50.0%", "8", "0", "0", "0", "0", "wh2,wh1", "(id=1, weight=1.0, user=u1)").
+			AddRow("test_rg", "7", "4", "50.0%", "8", "0", "0", "0", "0", "wh2,wh1", "(id=2, weight=1.0, role=r1)"),
+	)
+
+	rg, err := client.GetResourceGroup("test_rg")
+	if err != nil {
+		t.Fatalf("GetResourceGroup failed: %v", err)
+	}
+
+	if rg.ID.ValueInt64() != 7 {
+		t.Errorf("ID = %d, want 7", rg.ID.ValueInt64())
+	}
+	if rg.CPUCoreLimit.ValueInt64() != 4 {
+		t.Errorf("CPUCoreLimit = %d, want 4", rg.CPUCoreLimit.ValueInt64())
+	}
+	if !rg.CPUWeight.IsNull() {
+		t.Errorf("CPUWeight = %v, want null", rg.CPUWeight)
+	}
+	if rg.MaxCPUCores.ValueInt64() != 8 {
+		t.Errorf("MaxCPUCores = %d, want 8", rg.MaxCPUCores.ValueInt64())
+	}
+	if !rg.ConcurrencyLimit.IsNull() || !rg.BigQueryMemLimit.IsNull() {
+		t.Errorf("zero limits should be null, got %v and %v", rg.ConcurrencyLimit, rg.BigQueryMemLimit)
+	}
+	if len(rg.Warehouses.Elements()) != 2 {
+		t.Errorf("Warehouses = %v, want 2 elements", rg.Warehouses)
+	}
+	if len(rg.Classifiers.Elements()) != 2 {
+		t.Errorf("Classifiers has %d elements, want 2", len(rg.Classifiers.Elements()))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetResourceGroup_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery("SHOW RESOURCE GROUP `missing_rg`").WillReturnRows(
+		sqlmock.NewRows([]string{"name", "id", "cpu_weight", "classifiers"}),
+	)
+
+	_, err = client.GetResourceGroup("missing_rg")
+	if !IsNotFound(err) {
+		t.Errorf("GetResourceGroup error = %v, want not found", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
 func TestParseClassifier(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -163,6 +287,31 @@ func TestParseClassifier(t *testing.T) {
 				User: types.StringValue("test_user"),
 			},
 		},
+		{
+			name:  "all fields",
+			input: "(id=10196, weight=4.459375, user=rg1_user1, role=rg1_role1, query_type in (SELECT, INSERT), source_ip=192.168.2.1/24, db='db1,db2', plan_cpu_cost_range=[1.0, 2.0), plan_mem_cost_range=[0.0, 1024.0))",
+			expected: Classifier{
+				ID:   10196,
+				User: types.StringValue("rg1_user1"),
+				Role: types.StringValue("rg1_role1"),
+				QueryType: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("select"),
+					types.StringValue("insert"),
+				}),
+				SourceIP:         types.StringValue("192.168.2.1/24"),
+				DB:               types.StringValue("db1,db2"),
+				PlanCPUCostRange: types.StringValue("[1.0, 2.0)"),
+				PlanMemCostRange: types.StringValue("[0.0, 1024.0)"),
+			},
+		},
+		{
+			name:  "role without user",
+			input: "(id=7, weight=1.0, role=admin)",
+			expected: Classifier{
+				ID:   7,
+				Role: types.StringValue("admin"),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -171,6 +320,210 @@ func TestParseClassifier(t *testing.T) {
 			if result.ID != tt.expected.ID {
 				t.Errorf("parseClassifier(%q).ID = %v, want %v", tt.input, result.ID, tt.expected.ID)
 			}
+			if classifierKey(result) != classifierKey(tt.expected) {
+				t.Errorf("parseClassifier(%q) = %q, want %q", tt.input, classifierKey(result), classifierKey(tt.expected))
+			}
 		})
 	}
 }
+
+func TestNormalizeCostRange(t *testing.T) {
+	tests := map[string]string{
+		"[1.0, 100.0)": "[1, 100)",
+		"[1,100)":      "[1, 100)",
+		"(0.5, 2]":     "(0.5, 2]",
+		"invalid":      "invalid",
+	}
+
+	for input, want := range tests {
+		if got := normalizeCostRange(input); got != want {
+			t.Errorf("normalizeCostRange(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestMergeClassifiers(t *testing.T) {
+	prior := []Classifier{
+		{Role: types.StringValue("admin"), PlanCPUCostRange: types.StringValue("[1, 2)")},
+		{User: types.StringValue("dropped")},
+		{User: types.StringValue("u1")},
+	}
+	current := []Classifier{
+		parseClassifier("(id=1, weight=1.0, user=u1)"),
+		parseClassifier("(id=2, weight=1.0, user=added)"),
+		parseClassifier("(id=3, weight=1.0, role=admin, plan_cpu_cost_range=[1.0, 2.0))"),
+	}
+
+	merged := mergeClassifiers(prior, current)
+	var got []string
+	for _, c := range merged {
+		got = append(got, strings.Join(classifierConditions(c), ", "))
+	}
+	want := []string{
+		"role='admin', plan_cpu_cost_range='[1, 2)'",
+		"user='u1'",
+		"user='added'",
+	}
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Errorf("mergeClassifiers() = %v, want %v", got, want)
+	}
+}
+
+func TestChangedResourceGroupProperties(t *testing.T) {
+	before := &resourceGroupResourceModel{
+		Name:             types.StringValue("rg_test"),
+		CPUWeight:        types.Int64Value(1),
+		MemLimit:         NewPercentageValue("80%"),
+		ConcurrencyLimit: types.Int64Value(10),
+	}
+	after := &resourceGroupResourceModel{
+		Name:             types.StringValue("rg_test"),
+		CPUWeight:        types.Int64Value(1),
+		MemLimit:         NewPercentageValue("50%"),
+		BigQueryMemLimit: types.Int64Value(1073741824),
+	}
+
+	got := changedResourceGroupProperties(before, after)
+	want := []string{
+		"'mem_limit' = '50%'",
+		"'concurrency_limit' = '0'",
+		"'big_query_mem_limit' = '1073741824'",
+	}
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Errorf("changedResourceGroupProperties() = %v, want %v", got, want)
+	}
+
+	// Percentages are compared numerically, e.g. after an import.
+	before.MemLimit = NewPercentageValue("80.0%")
+	after = &resourceGroupResourceModel{
+		Name:             types.StringValue("rg_test"),
+		CPUWeight:        types.Int64Value(1),
+		MemLimit:         NewPercentageValue("80%"),
+		ConcurrencyLimit: types.Int64Value(10),
+	}
+	if got := changedResourceGroupProperties(before, after); len(got) != 0 {
+		t.Errorf("changedResourceGroupProperties() = %v, want none", got)
+	}
+}
+
+func TestDiffClassifiers(t *testing.T) {
+	user := Classifier{User: types.StringValue("u1")}
+	role := Classifier{Role: types.StringValue("admin")}
+	db := Classifier{DB: types.StringValue("analytics")}
+
+	added, removed := diffClassifiers([]Classifier{user, role}, []Classifier{role, db})
+	if len(added) != 1 || classifierKey(added[0]) != classifierKey(db) {
+		t.Errorf("added = %v, want [%s]", added, classifierKey(db))
+	}
+	if len(removed) != 1 || classifierKey(removed[0]) != classifierKey(user) {
+		t.Errorf("removed = %v, want [%s]", removed, classifierKey(user))
+	}
+}
+
+func TestMatchClassifierIDs(t *testing.T) {
+	current := []Classifier{
+		parseClassifier("(id=10, weight=1.0, user=u1)"),
+		parseClassifier("(id=11, weight=2.0, role=admin, query_type in (SELECT))"),
+		parseClassifier("(id=12, weight=1.0, user=u1)"),
+	}
+	wanted := []Classifier{
+		{Role: types.StringValue("admin"), QueryType: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("select")})},
+		{User: types.StringValue("u1")},
+	}
+
+	ids := matchClassifierIDs(current, wanted)
+	if len(ids) != 2 || ids[0] != 11 || ids[1] != 10 {
+		t.Errorf("matchClassifierIDs() = %v, want [11 10]", ids)
+	}
+}
+
+func TestAlterResourceGroup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("ALTER RESOURCE GROUP `rg_test` WITH ('mem_limit' = '50%')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER RESOURCE GROUP `rg_test` ADD (user='u1'), (role='admin', query_type in ('select'))")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER RESOURCE GROUP `rg_test` DROP (10, 11)")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.AlterResourceGroupProperties("rg_test", []string{"'mem_limit' = '50%'"}); err != nil {
+		t.Fatalf("AlterResourceGroupProperties failed: %v", err)
+	}
+	err = client.AddResourceGroupClassifiers("rg_test", []Classifier{
+		{User: types.StringValue("u1")},
+		{Role: types.StringValue("admin"), QueryType: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("SELECT")})},
+	})
+	if err != nil {
+		t.Fatalf("AddResourceGroupClassifiers failed: %v", err)
+	}
+	if err := client.DropResourceGroupClassifiers("rg_test", []int64{10, 11}); err != nil {
+		t.Fatalf("DropResourceGroupClassifiers failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectPing()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT current_version()")).WillReturnRows(
+		sqlmock.NewRows([]string{"current_version()"}).AddRow("3.3.5-6d81f75"),
+	)
+
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if got := client.Version().String(); got != "3.3.5" {
+		t.Errorf("Version() = %s, want 3.3.5", got)
+	}
+	if !client.SupportsVersion(3, 3, 0) || client.SupportsVersion(3, 4, 0) {
+		t.Errorf("SupportsVersion gives unexpected results for %s", client.Version())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestConnect_PingFailure(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectPing().WillReturnError(fmt.Errorf("access denied"))
+
+	if err := client.Connect(context.Background()); err == nil {
+		t.Error("Connect should fail when the ping fails")
+	}
+	if !client.Version().IsZero() || !client.SupportsVersion(9, 9, 9) {
+		t.Error("an unknown version should not restrict features")
+	}
+
+	mock.ExpectClose()
+	if err := client.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package starrocks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Authentication plugins supported by CREATE USER ... IDENTIFIED WITH.
+const (
+	authPluginNativePassword = "mysql_native_password"
+	authPluginLDAPSimple     = "authentication_ldap_simple"
+)
+
+// defaultUserHost is the host pattern of a user identity without one.
+const defaultUserHost = "%"
+
+type User struct {
+	Name        types.String
+	Host        types.String
+	AuthPlugin  types.String
+	LDAPDN      types.String
+	HasPassword bool
+}
+
+type UserModel interface {
+	GetName() types.String
+	GetHost() types.String
+	GetPassword() types.String
+	GetPasswordHash() types.String
+	GetAuthPlugin() types.String
+	GetLDAPDN() types.String
+	GetDefaultRoles() types.Set
+}
+
+// userIdentity renders a 'name'@'host' user identity.
+func userIdentity(name, host string) string {
+	if host == "" {
+		host = defaultUserHost
+	}
+	return quoteString(name) + "@" + quoteString(host)
+}
+
+func (c *Client) CreateUser(u UserModel) error {
+	query := "CREATE USER " + userIdentity(u.GetName().ValueString(), u.GetHost().ValueString()) + userAuthClause(u)
+	if roles := stringSetValues(u.GetDefaultRoles()); len(roles) > 0 {
+		query += " DEFAULT ROLE " + quoteIdentifiers(roles)
+	}
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// AlterUserAuthentication replaces the authentication method and credentials
+// of an existing user.
+func (c *Client) AlterUserAuthentication(u UserModel) error {
+	query := "ALTER USER " + userIdentity(u.GetName().ValueString(), u.GetHost().ValueString()) + userAuthClause(u)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetUserDefaultRoles replaces the roles activated when the user connects.
+// An empty set clears them.
+func (c *Client) SetUserDefaultRoles(name, host string, roles types.Set) error {
+	values := stringSetValues(roles)
+	if len(values) == 0 {
+		return c.SetDefaultRoles(name, host, nil)
+	}
+
+	query := "ALTER USER " + userIdentity(name, host) + " DEFAULT ROLE " + quoteIdentifiers(values)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetDefaultRoles runs SET DEFAULT ROLE for a user. The roles must already
+// be granted to the user; no roles means NONE.
+func (c *Client) SetDefaultRoles(name, host string, roles []string) error {
+	list := "NONE"
+	if len(roles) > 0 {
+		list = quoteIdentifiers(roles)
+	}
+
+	query := "SET DEFAULT ROLE " + list + " TO " + userIdentity(name, host)
+	_, err := c.db.Exec(query)
+	return wrapUserNotFound(err, "user", name)
+}
+
+// GrantRolesToUser grants roles to a user.
+func (c *Client) GrantRolesToUser(name, host string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	query := "GRANT " + quoteIdentifiers(roles) + " TO USER " + userIdentity(name, host)
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// RevokeRolesFromUser revokes roles from a user.
+func (c *Client) RevokeRolesFromUser(name, host string, roles []string) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	query := "REVOKE " + quoteIdentifiers(roles) + " FROM USER " + userIdentity(name, host)
+	_, err := c.db.Exec(query)
+	return wrapUserNotFound(err, "user", name)
+}
+
+// GetUserRoles returns the roles granted to a user, as listed by SHOW GRANTS.
+func (c *Client) GetUserRoles(name, host string) ([]string, error) {
+	rows, err := c.queryRows("SHOW GRANTS FOR " + userIdentity(name, host))
+	if err != nil {
+		return nil, wrapUserNotFound(err, "user", name)
+	}
+
+	var roles []string
+	for _, row := range rows {
+		for _, stmt := range strings.Split(row.get("grants"), "\n") {
+			roles = append(roles, parseRoleGrant(stmt)...)
+		}
+	}
+	return dedupeStrings(roles), nil
+}
+
+// GetUserDefaultRoles returns the default roles of a user, as listed by the
+// SET DEFAULT ROLE statement in SHOW GRANTS.
+func (c *Client) GetUserDefaultRoles(name, host string) ([]string, error) {
+	rows, err := c.queryRows("SHOW GRANTS FOR " + userIdentity(name, host))
+	if err != nil {
+		return nil, wrapUserNotFound(err, "user", name)
+	}
+
+	var roles []string
+	for _, row := range rows {
+		for _, stmt := range strings.Split(row.get("grants"), "\n") {
+			roles = append(roles, parseDefaultRoleGrant(stmt)...)
+		}
+	}
+	return dedupeStrings(roles), nil
+}
+
+// parseDefaultRoleGrant extracts the role names from a statement such as
+// "SET DEFAULT ROLE 'analyst', 'reader' TO 'jack'@'%'". NONE and other
+// statements yield no roles.
+func parseDefaultRoleGrant(stmt string) []string {
+	stmt = strings.TrimSuffix(strings.TrimSpace(stmt), ";")
+	upper := strings.ToUpper(stmt)
+	if !strings.HasPrefix(upper, "SET DEFAULT ROLE ") {
+		return nil
+	}
+	to := strings.LastIndex(upper, " TO ")
+	if to < 0 {
+		return nil
+	}
+
+	list := strings.TrimSpace(stmt[len("SET DEFAULT ROLE "):to])
+	if strings.EqualFold(list, "NONE") {
+		return nil
+	}
+
+	var roles []string
+	for _, item := range splitNameList(list) {
+		if name := unquoteName(item); name != "" {
+			roles = append(roles, name)
+		}
+	}
+	return roles
+}
+
+// userAuthClause renders the IDENTIFIED clause for the credentials of u. A
+// native password user without credentials gets an empty password, which
+// also clears a previous one on ALTER USER.
+func userAuthClause(u UserModel) string {
+	if strings.EqualFold(u.GetAuthPlugin().ValueString(), authPluginLDAPSimple) {
+		clause := " IDENTIFIED WITH " + authPluginLDAPSimple
+		if dn := u.GetLDAPDN(); !dn.IsNull() && !dn.IsUnknown() {
+			clause += " AS " + quoteString(dn.ValueString())
+		}
+		return clause
+	}
+
+	if hash := u.GetPasswordHash(); !hash.IsNull() && !hash.IsUnknown() {
+		return " IDENTIFIED WITH " + authPluginNativePassword + " AS " + quoteString(hash.ValueString())
+	}
+	return " IDENTIFIED BY " + quoteString(u.GetPassword().ValueString())
+}
+
+func (c *Client) GetUser(name, host string) (*User, error) {
+	if host == "" {
+		host = defaultUserHost
+	}
+
+	rows, err := c.queryRows("SHOW AUTHENTICATION FOR " + userIdentity(name, host))
+	if err != nil {
+		return nil, wrapUserNotFound(err, "user", name)
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "user", Name: name}
+	}
+	row := rows[0]
+
+	user := &User{
+		Name:        types.StringValue(name),
+		Host:        types.StringValue(host),
+		AuthPlugin:  types.StringValue(authPluginNativePassword),
+		LDAPDN:      parseStringColumn(row.get("userforauthplugin")),
+		HasPassword: strings.EqualFold(row.get("password"), "yes"),
+	}
+	if plugin := row.get("authplugin"); plugin != "" {
+		user.AuthPlugin = types.StringValue(strings.ToLower(plugin))
+	}
+
+	return user, nil
+}
+
+func (c *Client) DropUser(name, host string) error {
+	_, err := c.db.Exec("DROP USER " + userIdentity(name, host))
+	return wrapUserNotFound(err, "user", name)
+}
+
+// parseUserIdentity splits a user identity such as 'jack'@'%', jack@% or
+// jack into its name and host. The host defaults to %.
+func parseUserIdentity(s string) (string, string, error) {
+	name, rest, err := cutUserIdentityPart(strings.TrimSpace(s))
+	if err != nil {
+		return "", "", err
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("user identity %q has no user name", s)
+	}
+	if rest == "" {
+		return name, defaultUserHost, nil
+	}
+
+	if !strings.HasPrefix(rest, "@") {
+		return "", "", fmt.Errorf("unexpected %q in user identity %q", rest, s)
+	}
+	host, rest, err := cutUserIdentityPart(rest[1:])
+	if err != nil {
+		return "", "", err
+	}
+	if rest != "" {
+		return "", "", fmt.Errorf("unexpected %q in user identity %q", rest, s)
+	}
+	if host == "" {
+		host = defaultUserHost
+	}
+	return name, host, nil
+}
+
+// sameUserIdentity reports whether two user identities name the same user,
+// e.g. 'jack'@'%', jack@% and jack. Identities that do not parse are
+// compared as written.
+func sameUserIdentity(a, b string) bool {
+	nameA, hostA, errA := parseUserIdentity(a)
+	nameB, hostB, errB := parseUserIdentity(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return nameA == nameB && hostA == hostB
+}
+
+// cutUserIdentityPart reads a quoted or bare user or host name from the
+// start of s and returns it with the remaining text.
+func cutUserIdentityPart(s string) (string, string, error) {
+	if s == "" || !strings.ContainsRune("'\"`", rune(s[0])) {
+		if i := strings.LastIndex(s, "@"); i >= 0 {
+			return s[:i], s[i:], nil
+		}
+		return s, "", nil
+	}
+
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && quote != '`' && i+1 < len(s):
+			i++
+			b.WriteByte(unescapeByte(s[i]))
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			b.WriteByte(c)
+		case c == quote:
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quote in %q", s)
+}
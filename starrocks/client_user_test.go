@@ -0,0 +1,284 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testUser(name string) *userResourceModel {
+	return &userResourceModel{
+		Name:         types.StringValue(name),
+		Host:         types.StringValue("%"),
+		Password:     types.StringNull(),
+		PasswordHash: types.StringNull(),
+		AuthPlugin:   types.StringValue(authPluginNativePassword),
+		LDAPDN:       types.StringNull(),
+		DefaultRoles: types.SetNull(types.StringType),
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	withPassword := testUser("jack")
+	withPassword.Host = types.StringValue("192.168.%")
+	withPassword.Password = types.StringValue("it's secret")
+	withPassword.DefaultRoles = types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("writer"),
+		types.StringValue("reader"),
+	})
+
+	withHash := testUser("etl")
+	withHash.PasswordHash = types.StringValue("*6BB4837EB74329105EE4568DDA7DC67ED2CA2AD9")
+
+	withLDAP := testUser("tom")
+	withLDAP.AuthPlugin = types.StringValue(authPluginLDAPSimple)
+	withLDAP.LDAPDN = types.StringValue("uid=tom,ou=company,dc=example,dc=com")
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE USER 'jack'@'192.168.%' IDENTIFIED BY 'it\\'s secret' DEFAULT ROLE `reader`, `writer`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE USER 'etl'@'%' IDENTIFIED WITH mysql_native_password AS '*6BB4837EB74329105EE4568DDA7DC67ED2CA2AD9'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE USER 'tom'@'%' IDENTIFIED WITH authentication_ldap_simple AS 'uid=tom,ou=company,dc=example,dc=com'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	for _, u := range []*userResourceModel{withPassword, withHash, withLDAP} {
+		if err := client.CreateUser(u); err != nil {
+			t.Fatalf("CreateUser(%s) failed: %v", u.Name.ValueString(), err)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestAlterUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("ALTER USER 'jack'@'%' IDENTIFIED BY ''")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER USER 'jack'@'%' DEFAULT ROLE `reader`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET DEFAULT ROLE NONE TO 'jack'@'%'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := client.AlterUserAuthentication(testUser("jack")); err != nil {
+		t.Fatalf("AlterUserAuthentication failed: %v", err)
+	}
+	roles := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("reader")})
+	if err := client.SetUserDefaultRoles("jack", "%", roles); err != nil {
+		t.Fatalf("SetUserDefaultRoles failed: %v", err)
+	}
+	if err := client.SetUserDefaultRoles("jack", "%", types.SetNull(types.StringType)); err != nil {
+		t.Fatalf("SetUserDefaultRoles failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	columns := []string{"UserIdentity", "Password", "AuthPlugin", "UserForAuthPlugin"}
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW AUTHENTICATION FOR 'jack'@'%'")).WillReturnRows(
+		sqlmock.NewRows(columns).AddRow("'jack'@'%'", "Yes", "MYSQL_NATIVE_PASSWORD", nil),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW AUTHENTICATION FOR 'tom'@'%'")).WillReturnRows(
+		sqlmock.NewRows(columns).AddRow("'tom'@'%'", "No", "AUTHENTICATION_LDAP_SIMPLE", "uid=tom,dc=example"),
+	)
+
+	jack, err := client.GetUser("jack", "")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if jack.AuthPlugin.ValueString() != authPluginNativePassword || !jack.HasPassword || !jack.LDAPDN.IsNull() {
+		t.Errorf("GetUser(jack) = %+v", jack)
+	}
+	if jack.Host.ValueString() != "%" {
+		t.Errorf("Host = %v, want %%", jack.Host)
+	}
+
+	tom, err := client.GetUser("tom", "%")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if tom.AuthPlugin.ValueString() != authPluginLDAPSimple || tom.HasPassword || tom.LDAPDN.ValueString() != "uid=tom,dc=example" {
+		t.Errorf("GetUser(tom) = %+v", tom)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW AUTHENTICATION FOR 'ghost'@'%'")).
+		WillReturnError(&mysql.MySQLError{Number: 1064, Message: "Getting analyzing error. Detail message: cannot find user 'ghost'@'%', user does not exist."})
+	mock.ExpectExec(regexp.QuoteMeta("DROP USER 'ghost'@'%'")).
+		WillReturnError(&mysql.MySQLError{Number: 1396, Message: "Operation DROP USER failed for 'ghost'@'%'"})
+
+	if _, err := client.GetUser("ghost", "%"); !IsNotFound(err) {
+		t.Errorf("GetUser error = %v, want not found", err)
+	}
+	if err := client.DropUser("ghost", "%"); !IsNotFound(err) {
+		t.Errorf("DropUser error = %v, want not found", err)
+	}
+}
+
+func TestParseUserIdentity(t *testing.T) {
+	tests := []struct {
+		input, name, host string
+	}{
+		{"'jack'@'%'", "jack", "%"},
+		{"'jack'@'192.168.%'", "jack", "192.168.%"},
+		{"jack@localhost", "jack", "localhost"},
+		{"jack", "jack", "%"},
+		{"`we@ird`@`%`", "we@ird", "%"},
+		{"'o''brien'@'%'", "o'brien", "%"},
+	}
+
+	for _, tt := range tests {
+		name, host, err := parseUserIdentity(tt.input)
+		if err != nil {
+			t.Errorf("parseUserIdentity(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if name != tt.name || host != tt.host {
+			t.Errorf("parseUserIdentity(%q) = %q, %q, want %q, %q", tt.input, name, host, tt.name, tt.host)
+		}
+	}
+
+	for _, input := range []string{"", "'jack", "'jack'x", "'jack'@'%'x"} {
+		if _, _, err := parseUserIdentity(input); err == nil {
+			t.Errorf("parseUserIdentity(%q) should fail", input)
+		}
+	}
+}
+
+func TestSameUserIdentity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"'jane'@'%'", "jane", true},
+		{"'jane'@'%'", "jane@%", true},
+		{"jane@localhost", "`jane`@'localhost'", true},
+		{"'jane'@'%'", "jane@localhost", false},
+		{"'jane'@'%'", "john", false},
+		{"'jane", "'jane", true},
+	}
+
+	for _, tt := range tests {
+		if got := sameUserIdentity(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameUserIdentity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestUserRoles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("GRANT `analyst`, `reader` TO USER 'jack'@'%'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET DEFAULT ROLE `analyst` TO 'jack'@'%'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("REVOKE `reader` FROM USER 'jack'@'%'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	grants := sqlmock.NewRows([]string{"UserIdentity", "Catalog", "Grants"}).
+		AddRow("'jack'@'%'", nil, "GRANT 'analyst', 'reader' TO 'jack'@'%'\nSET DEFAULT ROLE 'analyst' TO 'jack'@'%'").
+		AddRow("'jack'@'%'", "default_catalog", "GRANT SELECT ON TABLE db.t TO USER 'jack'@'%'")
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GRANTS FOR 'jack'@'%'")).WillReturnRows(grants)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GRANTS FOR 'jack'@'%'")).WillReturnRows(
+		sqlmock.NewRows([]string{"UserIdentity", "Catalog", "Grants"}).
+			AddRow("'jack'@'%'", nil, "GRANT 'analyst', 'reader' TO 'jack'@'%'\nSET DEFAULT ROLE 'analyst' TO 'jack'@'%'"),
+	)
+
+	if err := client.GrantRolesToUser("jack", "%", []string{"analyst", "reader"}); err != nil {
+		t.Fatalf("GrantRolesToUser failed: %v", err)
+	}
+	if err := client.SetDefaultRoles("jack", "%", []string{"analyst"}); err != nil {
+		t.Fatalf("SetDefaultRoles failed: %v", err)
+	}
+	if err := client.RevokeRolesFromUser("jack", "%", []string{"reader"}); err != nil {
+		t.Fatalf("RevokeRolesFromUser failed: %v", err)
+	}
+
+	roles, err := client.GetUserRoles("jack", "%")
+	if err != nil {
+		t.Fatalf("GetUserRoles failed: %v", err)
+	}
+	if len(roles) != 2 || roles[0] != "analyst" || roles[1] != "reader" {
+		t.Errorf("GetUserRoles() = %v, want [analyst reader]", roles)
+	}
+
+	defaults, err := client.GetUserDefaultRoles("jack", "%")
+	if err != nil {
+		t.Fatalf("GetUserDefaultRoles failed: %v", err)
+	}
+	if len(defaults) != 1 || defaults[0] != "analyst" {
+		t.Errorf("GetUserDefaultRoles() = %v, want [analyst]", defaults)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestParseDefaultRoleGrant(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want []string
+	}{
+		{"SET DEFAULT ROLE 'analyst', 'reader' TO 'jack'@'%';", []string{"analyst", "reader"}},
+		{"SET DEFAULT ROLE `analyst` TO 'jack'@'%'", []string{"analyst"}},
+		{"SET DEFAULT ROLE NONE TO 'jack'@'%'", nil},
+		{"GRANT 'analyst' TO 'jack'@'%'", nil},
+	}
+
+	for _, tt := range tests {
+		if got := parseDefaultRoleGrant(tt.stmt); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseDefaultRoleGrant(%q) = %v, want %v", tt.stmt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package starrocks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// variableLiteral renders a variable value for SET. Numbers and booleans are
+// left bare, anything else is quoted.
+func variableLiteral(value string) string {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	if strings.EqualFold(value, "true") || strings.EqualFold(value, "false") {
+		return value
+	}
+	return quoteString(value)
+}
+
+// SetGlobalVariable changes the global value of a system variable.
+func (c *Client) SetGlobalVariable(name, value string) error {
+	_, err := c.db.Exec("SET GLOBAL " + name + " = " + variableLiteral(value))
+	return err
+}
+
+// ResetGlobalVariable restores the server default of a system variable.
+func (c *Client) ResetGlobalVariable(name string) error {
+	_, err := c.db.Exec("SET GLOBAL " + name + " = DEFAULT")
+	return err
+}
+
+// GetGlobalVariable reads the global value of a system variable with SHOW
+// GLOBAL VARIABLES.
+func (c *Client) GetGlobalVariable(name string) (types.String, error) {
+	rows, err := c.queryRows("SHOW GLOBAL VARIABLES LIKE " + quoteString(name))
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	// LIKE treats "_" as a wildcard, so look for an exact match.
+	for _, row := range rows {
+		if strings.EqualFold(row.get("variable_name"), name) {
+			return types.StringValue(row.get("value")), nil
+		}
+	}
+
+	return types.StringNull(), &NotFoundError{Kind: "variable", Name: name}
+}
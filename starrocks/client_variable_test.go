@@ -0,0 +1,75 @@
+package starrocks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestVariableLiteral(t *testing.T) {
+	tests := map[string]string{
+		"300":           "300",
+		"0.5":           "0.5",
+		"true":          "true",
+		"FALSE":         "FALSE",
+		"ON":            "'ON'",
+		"Asia/Shanghai": "'Asia/Shanghai'",
+		"it's":          `'it\'s'`,
+	}
+
+	for in, want := range tests {
+		if got := variableLiteral(in); got != want {
+			t.Errorf("variableLiteral(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestGlobalVariable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	cols := []string{"Variable_name", "Value"}
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'query_timeout'")).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow("query_timeout", "300"))
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW GLOBAL VARIABLES LIKE 'enable_spill'")).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow("enable_spill_buffer", "false"))
+	mock.ExpectExec(regexp.QuoteMeta("SET GLOBAL query_timeout = 600")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET GLOBAL time_zone = 'UTC'")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET GLOBAL enable_spill = DEFAULT")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	got, err := client.GetGlobalVariable("query_timeout")
+	if err != nil {
+		t.Fatalf("GetGlobalVariable failed: %v", err)
+	}
+	if got.ValueString() != "300" {
+		t.Errorf("GetGlobalVariable = %v, want 300", got)
+	}
+
+	// LIKE matches other variables too, so the name must match exactly.
+	if _, err := client.GetGlobalVariable("enable_spill"); !IsNotFound(err) {
+		t.Errorf("GetGlobalVariable(enable_spill) error = %v, want not found", err)
+	}
+
+	if err := client.SetGlobalVariable("query_timeout", "600"); err != nil {
+		t.Fatalf("SetGlobalVariable failed: %v", err)
+	}
+	if err := client.SetGlobalVariable("time_zone", "UTC"); err != nil {
+		t.Fatalf("SetGlobalVariable failed: %v", err)
+	}
+	if err := client.ResetGlobalVariable("enable_spill"); err != nil {
+		t.Fatalf("ResetGlobalVariable failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,366 @@
+package starrocks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ViewColumn struct {
+	Name    types.String
+	Comment types.String
+}
+
+type View struct {
+	Database types.String
+	Name     types.String
+	Query    types.String
+	Comment  types.String
+	Columns  []ViewColumn
+}
+
+var viewColumnAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"comment": types.StringType,
+}
+
+// CreateView creates a view, or replaces the definition of an existing one
+// when replace is set.
+func (c *Client) CreateView(v *View, replace bool) error {
+	query := "CREATE VIEW "
+	if replace {
+		query = "CREATE OR REPLACE VIEW "
+	}
+	query += tableIdentifier(v.Database.ValueString(), v.Name.ValueString()) + viewColumnList(v.Columns)
+	if !v.Comment.IsNull() && !v.Comment.IsUnknown() {
+		query += " COMMENT " + quoteString(v.Comment.ValueString())
+	}
+	query += " AS " + viewQuery(v.Query.ValueString())
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// AlterView replaces the column list and query of a view, keeping its
+// comment.
+func (c *Client) AlterView(v *View) error {
+	query := "ALTER VIEW " + tableIdentifier(v.Database.ValueString(), v.Name.ValueString()) +
+		viewColumnList(v.Columns) + " AS " + viewQuery(v.Query.ValueString())
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "view", v.Name.ValueString())
+}
+
+// viewColumnList renders the optional column list of CREATE and ALTER VIEW.
+func viewColumnList(columns []ViewColumn) string {
+	if len(columns) == 0 {
+		return ""
+	}
+
+	defs := make([]string, len(columns))
+	for i, col := range columns {
+		defs[i] = quoteIdentifier(col.Name.ValueString())
+		if !col.Comment.IsNull() && !col.Comment.IsUnknown() {
+			defs[i] += " COMMENT " + quoteString(col.Comment.ValueString())
+		}
+	}
+	return " (" + strings.Join(defs, ", ") + ")"
+}
+
+// viewQuery strips the trailing semicolon a query may be written with.
+func viewQuery(q string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(q), ";"))
+}
+
+func (c *Client) GetView(database, name string) (*View, error) {
+	where := fmt.Sprintf("WHERE TABLE_SCHEMA = %s AND TABLE_NAME = %s", quoteString(database), quoteString(name))
+
+	rows, err := c.queryRows("SELECT VIEW_DEFINITION FROM information_schema.views " + where)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &NotFoundError{Kind: "view", Name: name}
+	}
+
+	v := &View{
+		Database: types.StringValue(database),
+		Name:     types.StringValue(name),
+		Query:    types.StringValue(rows[0].get("view_definition")),
+		Comment:  types.StringNull(),
+	}
+
+	rows, err = c.queryRows("SELECT TABLE_COMMENT FROM information_schema.tables " + where)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > 0 {
+		v.Comment = parseStringColumn(rows[0].get("table_comment"))
+	}
+
+	rows, err = c.queryRows("SELECT COLUMN_NAME, COLUMN_COMMENT FROM information_schema.columns " + where + " ORDER BY ORDINAL_POSITION")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		v.Columns = append(v.Columns, ViewColumn{
+			Name:    types.StringValue(row.get("column_name")),
+			Comment: parseStringColumn(row.get("column_comment")),
+		})
+	}
+
+	return v, nil
+}
+
+func (c *Client) DropView(database, name string) error {
+	_, err := c.db.Exec("DROP VIEW " + tableIdentifier(database, name))
+	return wrapNotFound(err, "view", name)
+}
+
+func viewColumnsFromList(list types.List) []ViewColumn {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var columns []ViewColumn
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		str := func(name string) types.String {
+			if v, ok := attrs[name].(types.String); ok {
+				return v
+			}
+			return types.StringNull()
+		}
+		columns = append(columns, ViewColumn{
+			Name:    str("name"),
+			Comment: str("comment"),
+		})
+	}
+	return columns
+}
+
+func viewColumnsToList(columns []ViewColumn) types.List {
+	objType := types.ObjectType{AttrTypes: viewColumnAttrTypes}
+	elems := make([]attr.Value, len(columns))
+	for i, col := range columns {
+		elems[i] = types.ObjectValueMust(viewColumnAttrTypes, map[string]attr.Value{
+			"name":    col.Name,
+			"comment": col.Comment,
+		})
+	}
+	return types.ListValueMust(objType, elems)
+}
+
+// sameViewQuery reports whether a configured query and the definition
+// StarRocks stored for it are the same query. The FE rewrites view queries
+// with quoted, fully qualified identifiers and adds aliases, e.g.
+// "SELECT id FROM orders" is stored as
+// "SELECT `db`.`orders`.`id` AS `id` FROM `db`.`orders`". Identifiers are
+// compared unquoted, the view's own database is dropped from qualified names,
+// and so is the table qualifier of queries that read a single table. Aliases
+// that repeat the selected expression are ignored.
+func sameViewQuery(database, a, b string) bool {
+	return slices.Equal(canonicalQueryTokens(database, a), canonicalQueryTokens(database, b))
+}
+
+// canonicalQueryTokens splits a query into tokens, drops the qualifiers
+// described in sameViewQuery and redundant aliases, and removes the optional
+// AS keyword.
+func canonicalQueryTokens(database, q string) []string {
+	tokens := queryTokens(viewQuery(q))
+	database = strings.ToLower(database)
+	tables := singleTableQualifiers(tokens, database)
+	tokens = stripQualifiers(tokens, database, tables)
+
+	var out []string
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i] != "as" || i+1 >= len(tokens) {
+			out = append(out, tokens[i])
+			continue
+		}
+		// A column selected as t.col is aliased as col.
+		alias := strings.Join(stripQualifiers(queryTokens(tokens[i+1]), database, tables), " ")
+		if item := selectItem(out); item != "" && (item == alias || strings.HasSuffix(item, "."+alias) && !strings.Contains(item, " ")) {
+			i++
+		}
+	}
+	return out
+}
+
+// selectItem returns the tokens of the expression that ends the token list,
+// back to the enclosing comma or SELECT.
+func selectItem(tokens []string) string {
+	depth := 0
+	for i := len(tokens) - 1; i >= 0; i-- {
+		switch tokens[i] {
+		case ")":
+			depth++
+		case "(":
+			if depth == 0 {
+				return strings.Join(tokens[i+1:], " ")
+			}
+			depth--
+		case ",", "select", "distinct":
+			if depth == 0 {
+				return strings.Join(tokens[i+1:], " ")
+			}
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// tableRefEnd lists the keywords that can follow a table reference, so that
+// they are not mistaken for its alias.
+var tableRefEnd = map[string]bool{
+	"where": true, "join": true, "inner": true, "left": true, "right": true, "full": true, "cross": true,
+	"outer": true, "semi": true, "anti": true, "on": true, "using": true, "group": true, "order": true,
+	"having": true, "limit": true, "union": true, "except": true, "intersect": true, "window": true,
+	"lateral": true,
+}
+
+// singleTableQualifiers returns the names a column can be qualified with
+// when every FROM and JOIN of the query reads the same table: the table with
+// and without its database, and its aliases. It returns nil otherwise, as the
+// qualifier then tells the tables apart.
+func singleTableQualifiers(tokens []string, database string) map[string]bool {
+	names := make(map[string]bool)
+	var table string
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i] != "from" && tokens[i] != "join" {
+			continue
+		}
+		for {
+			i++
+			if i >= len(tokens) || !isNameToken(tokens[i]) {
+				break
+			}
+			parts, next := qualifiedName(tokens, i)
+			if len(parts) > 1 && parts[0] == database {
+				parts = parts[1:]
+			}
+			name := strings.Join(parts, ".")
+			if table != "" && table != name {
+				return nil
+			}
+			table = name
+			names[name] = true
+			names[parts[len(parts)-1]] = true
+
+			i = next
+			if i < len(tokens) && tokens[i] == "as" {
+				i++
+			}
+			if i < len(tokens) && isNameToken(tokens[i]) && !tableRefEnd[tokens[i]] {
+				names[tokens[i]] = true
+				i++
+			}
+			if i >= len(tokens) || tokens[i] != "," {
+				i--
+				break
+			}
+		}
+	}
+	if table == "" {
+		return nil
+	}
+	return names
+}
+
+// qualifiedName reads a name such as db.tbl.col or tbl.* starting at
+// tokens[i] and returns its parts and the index after it.
+func qualifiedName(tokens []string, i int) ([]string, int) {
+	parts := []string{tokens[i]}
+	i++
+	for i+1 < len(tokens) && tokens[i] == "." && (isNameToken(tokens[i+1]) || tokens[i+1] == "*") {
+		parts = append(parts, tokens[i+1])
+		i += 2
+	}
+	return parts, i
+}
+
+// stripQualifiers drops the view's database from qualified names, and the
+// table qualifier when tables holds the names of the only table read.
+func stripQualifiers(tokens []string, database string, tables map[string]bool) []string {
+	out := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); {
+		if !isNameToken(tokens[i]) {
+			out = append(out, tokens[i])
+			i++
+			continue
+		}
+		parts, next := qualifiedName(tokens, i)
+		if len(parts) > 1 && parts[0] == database && !tables[parts[0]] {
+			parts = parts[1:]
+		}
+		if len(parts) > 1 && tables[strings.Join(parts[:len(parts)-1], ".")] {
+			parts = parts[len(parts)-1:]
+		}
+		out = append(out, strings.Join(parts, "."))
+		i = next
+	}
+	return out
+}
+
+func isNameToken(t string) bool {
+	return t != "" && t[0] != '\'' && strings.IndexByte("(),;=<>+-*/.", t[0]) < 0
+}
+
+// queryTokens splits a query into lower-cased words, unquoted identifiers,
+// string literals (always rendered with single quotes) and single-character
+// punctuation. Numbers keep their decimal point.
+func queryTokens(q string) []string {
+	var tokens []string
+	for i := 0; i < len(q); {
+		c := q[i]
+		switch {
+		case strings.IndexByte(" \t\r\n", c) >= 0:
+			i++
+		case c == '`' || c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(q); j++ {
+				if c != '`' && q[j] == '\\' && j+1 < len(q) {
+					j++
+					b.WriteByte(q[j])
+					continue
+				}
+				if q[j] == c {
+					if j+1 < len(q) && q[j+1] == c {
+						j++
+						b.WriteByte(c)
+						continue
+					}
+					break
+				}
+				b.WriteByte(q[j])
+			}
+			if c == '`' {
+				tokens = append(tokens, strings.ToLower(b.String()))
+			} else {
+				tokens = append(tokens, "'"+b.String()+"'")
+			}
+			i = j + 1
+		case isWordByte(c):
+			j := i
+			for j < len(q) && (isWordByte(q[j]) || c >= '0' && c <= '9' && q[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, strings.ToLower(q[i:j]))
+			i = j
+		default:
+			tokens = append(tokens, string(c))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
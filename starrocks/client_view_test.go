@@ -0,0 +1,178 @@
+package starrocks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateView(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE VIEW `analytics`.`daily_pv` (`dt` COMMENT 'event day', `pv`) COMMENT 'page views' AS SELECT dt, count(*) FROM events GROUP BY dt")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE OR REPLACE VIEW `analytics`.`daily_pv` AS SELECT 1")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER VIEW `analytics`.`daily_pv` (`d`) AS SELECT dt FROM events")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	view := &View{
+		Database: types.StringValue("analytics"),
+		Name:     types.StringValue("daily_pv"),
+		Query:    types.StringValue("SELECT dt, count(*) FROM events GROUP BY dt;\n"),
+		Comment:  types.StringValue("page views"),
+		Columns: []ViewColumn{
+			{Name: types.StringValue("dt"), Comment: types.StringValue("event day")},
+			{Name: types.StringValue("pv"), Comment: types.StringNull()},
+		},
+	}
+	if err := client.CreateView(view, false); err != nil {
+		t.Fatalf("CreateView failed: %v", err)
+	}
+
+	view.Query = types.StringValue("SELECT 1")
+	view.Comment = types.StringNull()
+	view.Columns = nil
+	if err := client.CreateView(view, true); err != nil {
+		t.Fatalf("CreateView failed: %v", err)
+	}
+
+	view.Query = types.StringValue("SELECT dt FROM events")
+	view.Columns = []ViewColumn{{Name: types.StringValue("d"), Comment: types.StringNull()}}
+	if err := client.AlterView(view); err != nil {
+		t.Fatalf("AlterView failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetView(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	where := "WHERE TABLE_SCHEMA = 'analytics' AND TABLE_NAME = 'daily_pv'"
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT VIEW_DEFINITION FROM information_schema.views " + where)).WillReturnRows(
+		sqlmock.NewRows([]string{"VIEW_DEFINITION"}).AddRow("SELECT `dt`, count(*) AS `pv` FROM `analytics`.`events` GROUP BY `dt`"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT TABLE_COMMENT FROM information_schema.tables " + where)).WillReturnRows(
+		sqlmock.NewRows([]string{"TABLE_COMMENT"}).AddRow("page views"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COLUMN_NAME, COLUMN_COMMENT FROM information_schema.columns " + where + " ORDER BY ORDINAL_POSITION")).WillReturnRows(
+		sqlmock.NewRows([]string{"COLUMN_NAME", "COLUMN_COMMENT"}).AddRow("dt", "event day").AddRow("pv", ""),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT VIEW_DEFINITION FROM information_schema.views WHERE TABLE_SCHEMA = 'analytics' AND TABLE_NAME = 'missing'")).
+		WillReturnRows(sqlmock.NewRows([]string{"VIEW_DEFINITION"}))
+
+	got, err := client.GetView("analytics", "daily_pv")
+	if err != nil {
+		t.Fatalf("GetView failed: %v", err)
+	}
+	if got.Comment.ValueString() != "page views" {
+		t.Errorf("Comment = %v, want page views", got.Comment)
+	}
+	if len(got.Columns) != 2 || got.Columns[0].Comment.ValueString() != "event day" || !got.Columns[1].Comment.IsNull() {
+		t.Errorf("Columns = %+v", got.Columns)
+	}
+
+	if _, err := client.GetView("analytics", "missing"); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestSameViewQuery(t *testing.T) {
+	tests := []struct {
+		config, server string
+		want           bool
+	}{
+		{
+			"SELECT id, amount, amount * 2 AS doubled FROM orders WHERE status = 'paid';",
+			"SELECT `analytics`.`orders`.`id` AS `id`, `analytics`.`orders`.`amount` AS `amount`, `analytics`.`orders`.`amount` * 2 AS `doubled`\nFROM `analytics`.`orders`\nWHERE `analytics`.`orders`.`status` = 'paid'",
+			true,
+		},
+		{
+			"select city, count(*) from events group by city",
+			"SELECT `analytics`.`events`.`city` AS `city`, count(*) AS `count(*)` FROM `analytics`.`events` GROUP BY `analytics`.`events`.`city`",
+			true,
+		},
+		{
+			"SELECT CAST(amount AS DECIMAL(10, 2)) total FROM orders",
+			"SELECT CAST(`analytics`.`orders`.`amount` AS DECIMAL(10,2)) AS `total` FROM `analytics`.`orders`",
+			true,
+		},
+		{
+			"SELECT id FROM orders WHERE status = 'paid'",
+			"SELECT `analytics`.`orders`.`id` AS `id` FROM `analytics`.`orders` WHERE `analytics`.`orders`.`status` = 'open'",
+			false,
+		},
+		{
+			"SELECT id FROM orders",
+			"SELECT `analytics`.`orders`.`amount` AS `amount` FROM `analytics`.`orders`",
+			false,
+		},
+		{
+			"SELECT id AS order_id FROM orders",
+			"SELECT `analytics`.`orders`.`id` AS `id` FROM `analytics`.`orders`",
+			false,
+		},
+		{
+			"SELECT o.id, c.name FROM orders o JOIN customers c ON o.customer_id = c.id",
+			"SELECT `o`.`id` AS `id`, `c`.`name` AS `name` FROM `analytics`.`orders` AS `o` INNER JOIN `analytics`.`customers` AS `c` ON `o`.`customer_id` = `c`.`id`",
+			false,
+		},
+		{
+			"SELECT o.id, c.name FROM orders o INNER JOIN customers c ON o.customer_id = c.id",
+			"SELECT `o`.`id` AS `id`, `c`.`name` AS `name` FROM `analytics`.`orders` AS `o` INNER JOIN `analytics`.`customers` AS `c` ON `o`.`customer_id` = `c`.`id`",
+			true,
+		},
+		{
+			"SELECT a.id FROM a JOIN b ON a.id = b.id",
+			"SELECT `analytics`.`b`.`id` AS `id` FROM `analytics`.`a` INNER JOIN `analytics`.`b` ON `analytics`.`a`.`id` = `analytics`.`b`.`id`",
+			false,
+		},
+		{
+			"SELECT id FROM sales.orders",
+			"SELECT `sales`.`orders`.`id` AS `id` FROM `sales`.`orders`",
+			true,
+		},
+		{
+			"SELECT id FROM sales.orders",
+			"SELECT `archive`.`orders`.`id` AS `id` FROM `archive`.`orders`",
+			false,
+		},
+		{
+			"SELECT id FROM orders",
+			"SELECT `archive`.`orders`.`id` AS `id` FROM `archive`.`orders`",
+			false,
+		},
+		{
+			"SELECT amount * 1.5 FROM orders",
+			"SELECT `analytics`.`orders`.`amount` * 15 FROM `analytics`.`orders`",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := sameViewQuery("analytics", tt.config, tt.server); got != tt.want {
+			t.Errorf("sameViewQuery(%q, %q) = %v, want %v", tt.config, tt.server, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package starrocks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// warehouseComputeReplica is the property that sets the number of compute
+// node groups of a warehouse.
+const warehouseComputeReplica = "compute_replica"
+
+type Warehouse struct {
+	Name       types.String
+	Comment    types.String
+	Suspended  types.Bool
+	NodeCount  types.Int64
+	Properties map[string]string
+}
+
+func (c *Client) CreateWarehouse(name string, comment types.String, props map[string]string) error {
+	query := "CREATE WAREHOUSE " + quoteIdentifier(name)
+	if !comment.IsNull() && !comment.IsUnknown() {
+		query += " COMMENT " + quoteString(comment.ValueString())
+	}
+	query += quotePropertiesClause(props)
+
+	_, err := c.db.Exec(query)
+	return err
+}
+
+// SetWarehouseProperties changes properties of a warehouse, such as the
+// number of compute node groups.
+func (c *Client) SetWarehouseProperties(name string, props map[string]string) error {
+	if len(props) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("ALTER WAREHOUSE %s SET (%s)", quoteIdentifier(name), quotePropertyList(props))
+	_, err := c.db.Exec(query)
+	return wrapNotFound(err, "warehouse", name)
+}
+
+// SetWarehouseSuspended suspends or resumes a warehouse.
+func (c *Client) SetWarehouseSuspended(name string, suspended bool) error {
+	action := "RESUME"
+	if suspended {
+		action = "SUSPEND"
+	}
+	_, err := c.db.Exec(action + " WAREHOUSE " + quoteIdentifier(name))
+	return wrapNotFound(err, "warehouse", name)
+}
+
+// GetWarehouse reads a warehouse with SHOW WAREHOUSES.
+func (c *Client) GetWarehouse(name string) (*Warehouse, error) {
+	rows, err := c.queryRows("SHOW WAREHOUSES LIKE " + quoteString(name))
+	if err != nil {
+		return nil, wrapNotFound(err, "warehouse", name)
+	}
+
+	// LIKE treats "_" and "%" as wildcards, so look for an exact match.
+	for _, row := range rows {
+		if row.get("name") != name {
+			continue
+		}
+
+		props, err := parseJSONProperties(row.get("property"))
+		if err != nil {
+			return nil, fmt.Errorf("parsing properties of warehouse %q: %w", name, err)
+		}
+
+		return &Warehouse{
+			Name:       types.StringValue(name),
+			Comment:    parseStringColumn(row.get("comment")),
+			Suspended:  types.BoolValue(strings.EqualFold(row.get("state"), "SUSPENDED")),
+			NodeCount:  parseInt64Column(row.get("nodecount"), false),
+			Properties: props,
+		}, nil
+	}
+
+	return nil, &NotFoundError{Kind: "warehouse", Name: name}
+}
+
+func (c *Client) DropWarehouse(name string) error {
+	_, err := c.db.Exec("DROP WAREHOUSE " + quoteIdentifier(name))
+	return wrapNotFound(err, "warehouse", name)
+}
@@ -0,0 +1,115 @@
+package starrocks
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCreateWarehouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE WAREHOUSE `etl` COMMENT 'batch jobs' PROPERTIES ('compute_replica' = '2')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER WAREHOUSE `etl` SET ('compute_replica' = '3')")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SUSPEND WAREHOUSE `etl`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("RESUME WAREHOUSE `etl`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DROP WAREHOUSE `etl`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	model := warehouseResourceModel{
+		ComputeReplica: types.Int64Value(2),
+		Properties:     types.MapNull(types.StringType),
+	}
+	if err := client.CreateWarehouse("etl", types.StringValue("batch jobs"), model.warehouseProperties()); err != nil {
+		t.Fatalf("CreateWarehouse failed: %v", err)
+	}
+	if err := client.SetWarehouseProperties("etl", nil); err != nil {
+		t.Fatalf("SetWarehouseProperties failed: %v", err)
+	}
+	if err := client.SetWarehouseProperties("etl", map[string]string{"compute_replica": "3"}); err != nil {
+		t.Fatalf("SetWarehouseProperties failed: %v", err)
+	}
+	if err := client.SetWarehouseSuspended("etl", true); err != nil {
+		t.Fatalf("SetWarehouseSuspended failed: %v", err)
+	}
+	if err := client.SetWarehouseSuspended("etl", false); err != nil {
+		t.Fatalf("SetWarehouseSuspended failed: %v", err)
+	}
+	if err := client.DropWarehouse("etl"); err != nil {
+		t.Fatalf("DropWarehouse failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetWarehouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	client := &Client{db: db}
+
+	cols := []string{"Id", "Name", "State", "NodeCount", "CurrentClusterCount", "MaxClusterCount",
+		"StartedClusters", "RunningSql", "QueuedSql", "CreatedOn", "ResumedOn", "UpdatedOn", "Property", "Comment"}
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW WAREHOUSES LIKE 'etl_wh'")).WillReturnRows(
+		sqlmock.NewRows(cols).
+			AddRow("10001", "etl1wh", "RUNNING", "1", "1", "1", "1", "0", "0", "", "", "", "", "").
+			AddRow("10002", "etl_wh", "SUSPENDED", "3", "1", "1", "0", "0", "0", "", "", "",
+				`{"compute_replica":"2","replication_type":"NONE"}`, "batch jobs"),
+	)
+	mock.ExpectQuery(regexp.QuoteMeta("SHOW WAREHOUSES LIKE 'missing'")).WillReturnRows(sqlmock.NewRows(cols))
+
+	got, err := client.GetWarehouse("etl_wh")
+	if err != nil {
+		t.Fatalf("GetWarehouse failed: %v", err)
+	}
+	if !got.Suspended.ValueBool() || got.NodeCount.ValueInt64() != 3 || got.Comment.ValueString() != "batch jobs" {
+		t.Errorf("GetWarehouse = %+v", got)
+	}
+	want := map[string]string{"compute_replica": "2", "replication_type": "NONE"}
+	if !reflect.DeepEqual(got.Properties, want) {
+		t.Errorf("Properties = %v, want %v", got.Properties, want)
+	}
+
+	// compute_replica is an attribute and untracked properties are ignored.
+	model := warehouseResourceModel{
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"replication_type": types.StringValue("SYNC"),
+		}),
+	}
+	if diags := model.refresh(got); diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	if model.ComputeReplica.ValueInt64() != 2 {
+		t.Errorf("ComputeReplica = %v, want 2", model.ComputeReplica)
+	}
+	if props := mapValues(model.Properties); !reflect.DeepEqual(props, map[string]string{"replication_type": "NONE"}) {
+		t.Errorf("Properties = %v", props)
+	}
+
+	if _, err := client.GetWarehouse("missing"); !IsNotFound(err) {
+		t.Errorf("GetWarehouse(missing) error = %v, want not found", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
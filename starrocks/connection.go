@@ -0,0 +1,171 @@
+package starrocks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// TLS modes supported by the provider.
+const (
+	TLSModeDisabled  = "disabled"
+	TLSModePreferred = "preferred"
+	TLSModeRequired  = "required"
+)
+
+// ClientConfig holds the connection settings used by NewClient.
+type ClientConfig struct {
+	// Host is the FE address in host:port form.
+	Host     string
+	Username string
+	Password string
+
+	TLS *TLSConfig
+
+	ConnectTimeout time.Duration
+	ReadTimeout    time.Duration
+	WriteTimeout   time.Duration
+
+	// Params are extra go-sql-driver DSN parameters, e.g. "charset".
+	Params map[string]string
+}
+
+// TLSConfig describes how to secure the connection to the FE.
+type TLSConfig struct {
+	// Mode is one of TLSModeDisabled, TLSModePreferred or TLSModeRequired.
+	Mode string
+	// CACert is a PEM bundle used instead of the system roots.
+	CACert string
+	// ClientCert and ClientKey are a PEM certificate and key for mutual TLS.
+	ClientCert string
+	ClientKey  string
+	// ServerName overrides the host name used to verify the certificate.
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// dsnParamPattern matches the names of DSN parameters. Other characters,
+// such as & or =, would let a key inject further parameters.
+var dsnParamPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// reservedDSNParams are DSN parameters set from typed provider attributes,
+// which Params must not override.
+var reservedDSNParams = []string{
+	"allowFallbackToPlaintext",
+	"readTimeout",
+	"timeout",
+	"tls",
+	"writeTimeout",
+}
+
+// tlsConfigSeq makes the names of registered TLS configurations unique, since
+// go-sql-driver keeps them in a process-wide registry.
+var tlsConfigSeq atomic.Uint64
+
+// buildDSN renders cfg as a go-sql-driver DSN, registering a TLS
+// configuration with the driver when needed.
+func buildDSN(cfg ClientConfig) (string, error) {
+	mc := mysql.NewConfig()
+	mc.User = cfg.Username
+	mc.Passwd = cfg.Password
+	mc.Net = "tcp"
+	mc.Addr = cfg.Host
+	mc.Timeout = cfg.ConnectTimeout
+	mc.ReadTimeout = cfg.ReadTimeout
+	mc.WriteTimeout = cfg.WriteTimeout
+
+	if cfg.TLS != nil && cfg.TLS.Mode != "" && cfg.TLS.Mode != TLSModeDisabled {
+		tlsConfig, err := cfg.TLS.build(cfg.Host)
+		if err != nil {
+			return "", err
+		}
+
+		name := fmt.Sprintf("starrocks-%d", tlsConfigSeq.Add(1))
+		if err := mysql.RegisterTLSConfig(name, tlsConfig); err != nil {
+			return "", fmt.Errorf("registering TLS configuration: %w", err)
+		}
+		mc.TLSConfig = name
+		mc.AllowFallbackToPlaintext = cfg.TLS.Mode == TLSModePreferred
+	}
+
+	dsn := mc.FormatDSN()
+	if len(cfg.Params) > 0 {
+		keys := make([]string, 0, len(cfg.Params))
+		for k := range cfg.Params {
+			if !dsnParamPattern.MatchString(k) {
+				return "", fmt.Errorf("connection parameter %q is not a valid parameter name", k)
+			}
+			if slices.Contains(reservedDSNParams, k) {
+				return "", fmt.Errorf("connection parameter %q is set by the provider and cannot be overridden", k)
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		params := make([]string, 0, len(keys))
+		for _, k := range keys {
+			// The driver unescapes values but not keys.
+			params = append(params, k+"="+url.QueryEscape(cfg.Params[k]))
+		}
+
+		sep := "?"
+		if strings.Contains(dsn, "?") {
+			sep = "&"
+		}
+		dsn += sep + strings.Join(params, "&")
+	}
+
+	// Round-trip through the driver so that invalid parameters are reported
+	// at configuration time rather than on the first query.
+	if _, err := mysql.ParseDSN(dsn); err != nil {
+		return "", fmt.Errorf("invalid connection parameters: %w", err)
+	}
+	return dsn, nil
+}
+
+// build converts the TLS settings into a tls.Config for the given address.
+func (t *TLSConfig) build(addr string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if tlsConfig.ServerName == "" {
+		host := addr
+		if i := strings.LastIndex(addr, ":"); i >= 0 {
+			host = addr[:i]
+		}
+		tlsConfig.ServerName = strings.Trim(host, "[]")
+	}
+
+	if t.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(t.CACert)) {
+			return nil, errors.New("ca_cert does not contain a valid PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if t.ClientCert != "" || t.ClientKey != "" {
+		if t.ClientCert == "" || t.ClientKey == "" {
+			return nil, errors.New("client_cert and client_key must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(t.ClientCert), []byte(t.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,150 @@
+package starrocks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestBuildDSN(t *testing.T) {
+	dsn, err := buildDSN(ClientConfig{
+		Host:           "localhost:9030",
+		Username:       "root",
+		Password:       "p@ss:word/",
+		ConnectTimeout: 5 * time.Second,
+		ReadTimeout:    time.Minute,
+		WriteTimeout:   30 * time.Second,
+		Params: map[string]string{
+			"charset":           "utf8mb4",
+			"interpolateParams": "true",
+		},
+	})
+	if err != nil {
+		t.Fatalf("buildDSN failed: %v", err)
+	}
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("ParseDSN(%q) failed: %v", dsn, err)
+	}
+	if cfg.Addr != "localhost:9030" || cfg.User != "root" || cfg.Passwd != "p@ss:word/" {
+		t.Errorf("unexpected address or credentials in %q", dsn)
+	}
+	if cfg.Timeout != 5*time.Second || cfg.ReadTimeout != time.Minute || cfg.WriteTimeout != 30*time.Second {
+		t.Errorf("timeouts = %v/%v/%v, want 5s/1m/30s", cfg.Timeout, cfg.ReadTimeout, cfg.WriteTimeout)
+	}
+	if !cfg.InterpolateParams {
+		t.Error("interpolateParams should be enabled")
+	}
+	if cfg.TLSConfig != "" {
+		t.Errorf("TLSConfig = %q, want none", cfg.TLSConfig)
+	}
+}
+
+func TestBuildDSN_InvalidParam(t *testing.T) {
+	_, err := buildDSN(ClientConfig{
+		Host:   "localhost:9030",
+		Params: map[string]string{"interpolateParams": "maybe"},
+	})
+	if err == nil {
+		t.Error("buildDSN should reject an invalid interpolateParams parameter")
+	}
+}
+
+func TestBuildDSN_ReservedParam(t *testing.T) {
+	for _, key := range reservedDSNParams {
+		_, err := buildDSN(ClientConfig{
+			Host:   "localhost:9030",
+			Params: map[string]string{key: "true"},
+		})
+		if err == nil || !strings.Contains(err.Error(), key) {
+			t.Errorf("buildDSN(%s) error = %v, want reserved parameter error", key, err)
+		}
+	}
+}
+
+func TestBuildDSN_InjectedParam(t *testing.T) {
+	for _, key := range []string{"charset=utf8&tls", "a&tls", "a=b", "1x", ""} {
+		_, err := buildDSN(ClientConfig{
+			Host:   "localhost:9030",
+			Params: map[string]string{key: "skip-verify"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "not a valid parameter name") {
+			t.Errorf("buildDSN(%q) error = %v, want invalid parameter name error", key, err)
+		}
+	}
+}
+
+func TestBuildDSN_TLS(t *testing.T) {
+	tests := []struct {
+		name         string
+		tls          *TLSConfig
+		wantTLS      bool
+		wantFallback bool
+		wantErr      string
+	}{
+		{
+			name: "disabled",
+			tls:  &TLSConfig{Mode: TLSModeDisabled},
+		},
+		{
+			name:    "required",
+			tls:     &TLSConfig{Mode: TLSModeRequired, ServerName: "fe.example.com"},
+			wantTLS: true,
+		},
+		{
+			name:         "preferred",
+			tls:          &TLSConfig{Mode: TLSModePreferred, InsecureSkipVerify: true},
+			wantTLS:      true,
+			wantFallback: true,
+		},
+		{
+			name:    "invalid ca",
+			tls:     &TLSConfig{Mode: TLSModeRequired, CACert: "not a certificate"},
+			wantErr: "ca_cert",
+		},
+		{
+			name:    "client cert without key",
+			tls:     &TLSConfig{Mode: TLSModeRequired, ClientCert: "cert"},
+			wantErr: "client_key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsn, err := buildDSN(ClientConfig{Host: "fe.local:9030", Username: "root", TLS: tt.tls})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("buildDSN error = %v, want error mentioning %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildDSN failed: %v", err)
+			}
+
+			cfg, err := mysql.ParseDSN(dsn)
+			if err != nil {
+				t.Fatalf("ParseDSN(%q) failed: %v", dsn, err)
+			}
+			if (cfg.TLS != nil) != tt.wantTLS {
+				t.Errorf("TLS configured = %v, want %v", cfg.TLS != nil, tt.wantTLS)
+			}
+			if cfg.AllowFallbackToPlaintext != tt.wantFallback {
+				t.Errorf("AllowFallbackToPlaintext = %v, want %v", cfg.AllowFallbackToPlaintext, tt.wantFallback)
+			}
+		})
+	}
+}
+
+func TestTLSConfig_DefaultServerName(t *testing.T) {
+	tlsConfig, err := (&TLSConfig{Mode: TLSModeRequired}).build("fe.example.com:9030")
+	if err != nil {
+		t.Fatalf("build failed: %v", err)
+	}
+	if tlsConfig.ServerName != "fe.example.com" {
+		t.Errorf("ServerName = %q, want fe.example.com", tlsConfig.ServerName)
+	}
+}
@@ -0,0 +1,284 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &databaseResource{}
+	_ resource.ResourceWithConfigure      = &databaseResource{}
+	_ resource.ResourceWithImportState    = &databaseResource{}
+	_ resource.ResourceWithValidateConfig = &databaseResource{}
+)
+
+func NewDatabaseResource() resource.Resource {
+	return &databaseResource{}
+}
+
+type databaseResource struct {
+	client *Client
+}
+
+type databaseResourceModel struct {
+	Catalog        types.String `tfsdk:"catalog"`
+	Name           types.String `tfsdk:"name"`
+	ReplicationNum types.Int64  `tfsdk:"replication_num"`
+	StorageVolume  types.String `tfsdk:"storage_volume"`
+	DataQuota      types.Int64  `tfsdk:"data_quota"`
+	ReplicaQuota   types.Int64  `tfsdk:"replica_quota"`
+}
+
+func (m *databaseResourceModel) GetCatalog() types.String       { return m.Catalog }
+func (m *databaseResourceModel) GetName() types.String          { return m.Name }
+func (m *databaseResourceModel) GetReplicationNum() types.Int64 { return m.ReplicationNum }
+func (m *databaseResourceModel) GetStorageVolume() types.String { return m.StorageVolume }
+
+// requiresReplaceCatalog forces a new database when the catalog changes,
+// treating an omitted catalog and default_catalog as the same.
+func requiresReplaceCatalog(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !isDefaultCatalog(req.StateValue.ValueString()) || !isDefaultCatalog(req.PlanValue.ValueString())
+}
+
+func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks database.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceCatalog,
+						"Changing the catalog forces a new database.",
+						"Changing the catalog forces a new database.",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replication_num": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"storage_volume": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"data_quota": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"replica_quota": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *databaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config databaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Catalog.IsUnknown() {
+		return
+	}
+
+	if isDefaultCatalog(config.Catalog.ValueString()) {
+		return
+	}
+	for _, attr := range []string{"data_quota", "replica_quota"} {
+		var v types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &v)...)
+		if !v.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Unsupported Database Quota",
+				fmt.Sprintf("%s can only be set on databases of the default catalog.", attr),
+			)
+		}
+	}
+}
+
+func (r *databaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateDatabase(&plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Database", err.Error())
+		return
+	}
+
+	if !plan.DataQuota.IsNull() {
+		if err := r.client.SetDatabaseDataQuota(plan.Name.ValueString(), plan.DataQuota); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Database Data Quota", err.Error())
+			return
+		}
+	}
+	if !plan.ReplicaQuota.IsNull() {
+		if err := r.client.SetDatabaseReplicaQuota(plan.Name.ValueString(), plan.ReplicaQuota); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Database Replica Quota", err.Error())
+			return
+		}
+	}
+
+	// Fill in the server default of computed properties
+	if plan.StorageVolume.IsUnknown() {
+		db, err := r.client.GetDatabase(plan.Catalog.ValueString(), plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading database", err.Error())
+			return
+		}
+		plan.StorageVolume = db.StorageVolume
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, err := r.client.GetDatabase(state.Catalog.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading database", err.Error())
+		return
+	}
+
+	// SHOW CREATE DATABASE only lists properties that were set explicitly
+	if !db.ReplicationNum.IsNull() {
+		state.ReplicationNum = db.ReplicationNum
+	}
+	state.StorageVolume = db.StorageVolume
+	if state.DataQuota.IsNull() || db.DataQuota.IsNull() || !sameByteSize(state.DataQuota.ValueInt64(), db.DataQuota.ValueInt64()) {
+		state.DataQuota = db.DataQuota
+	}
+	state.ReplicaQuota = db.ReplicaQuota
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	if !plan.DataQuota.Equal(state.DataQuota) {
+		if err := r.client.SetDatabaseDataQuota(name, plan.DataQuota); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Database Data Quota", err.Error())
+			return
+		}
+	}
+	if !plan.ReplicaQuota.Equal(state.ReplicaQuota) {
+		if err := r.client.SetDatabaseReplicaQuota(name, plan.ReplicaQuota); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Database Replica Quota", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropDatabase(state.Catalog.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Database", err.Error())
+	}
+}
+
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is either "name" or "catalog.name"
+	catalog, name := "", req.ID
+	if i := strings.Index(req.ID, "."); i >= 0 {
+		catalog, name = req.ID[:i], req.ID[i+1:]
+	}
+
+	db, err := r.client.GetDatabase(catalog, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing database", err.Error())
+		return
+	}
+
+	state := databaseResourceModel{
+		Catalog:        db.Catalog,
+		Name:           db.Name,
+		ReplicationNum: db.ReplicationNum,
+		StorageVolume:  db.StorageVolume,
+		DataQuota:      db.DataQuota,
+		ReplicaQuota:   db.ReplicaQuota,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,82 @@
+package starrocks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error codes that StarRocks uses for missing objects.
+const (
+	errBadDB       = 1049 // ER_BAD_DB_ERROR
+	errBadTable    = 1051 // ER_BAD_TABLE_ERROR
+	errNoSuchTable = 1146 // ER_NO_SUCH_TABLE
+)
+
+// errCannotUser is ER_CANNOT_USER. StarRocks returns it both for a missing
+// user and for CREATE USER on an existing one, so only wrapUserNotFound
+// treats it as not found.
+const errCannotUser = 1396
+
+// NotFoundError is returned by Client methods when the requested object does
+// not exist on the server.
+type NotFoundError struct {
+	Kind string
+	Name string
+	Err  error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s %q not found: %v", e.Kind, e.Name, e.Err)
+	}
+	return fmt.Sprintf("%s %q not found", e.Kind, e.Name)
+}
+
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// IsNotFound reports whether err indicates that an object does not exist.
+func IsNotFound(err error) bool {
+	var nf *NotFoundError
+	return errors.As(err, &nf)
+}
+
+// wrapNotFound converts driver errors that signal a missing object into a
+// NotFoundError for the given kind and name. Other errors are returned as is.
+func wrapNotFound(err error, kind, name string) error {
+	if err == nil {
+		return nil
+	}
+
+	var me *mysql.MySQLError
+	if !errors.As(err, &me) {
+		return err
+	}
+
+	switch me.Number {
+	case errBadDB, errBadTable, errNoSuchTable:
+		return &NotFoundError{Kind: kind, Name: name, Err: err}
+	}
+
+	// StarRocks reports most unknown objects as generic analyzer errors, so
+	// fall back to the message text.
+	msg := strings.ToLower(me.Message)
+	for _, marker := range []string{"not exist", "not found", "unknown database", "unknown table", "unknown catalog"} {
+		if strings.Contains(msg, marker) {
+			return &NotFoundError{Kind: kind, Name: name, Err: err}
+		}
+	}
+	return err
+}
+
+// wrapUserNotFound is wrapNotFound for statements that read or drop an
+// existing user, where ER_CANNOT_USER means the user is gone.
+func wrapUserNotFound(err error, kind, name string) error {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) && me.Number == errCannotUser {
+		return &NotFoundError{Kind: kind, Name: name, Err: err}
+	}
+	return wrapNotFound(err, kind, name)
+}
@@ -0,0 +1,85 @@
+package starrocks
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestWrapNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		notFound bool
+	}{
+		{
+			name:     "nil error",
+			err:      nil,
+			notFound: false,
+		},
+		{
+			name:     "unknown database code",
+			err:      &mysql.MySQLError{Number: 1049, Message: "Unknown database 'db1'"},
+			notFound: true,
+		},
+		{
+			name:     "analyzer message",
+			err:      &mysql.MySQLError{Number: 1064, Message: "Getting analyzing error. Detail message: resource group rg1 does not exist."},
+			notFound: true,
+		},
+		{
+			name:     "unknown catalog message",
+			err:      &mysql.MySQLError{Number: 1064, Message: "Unknown catalog 'hive_lake'"},
+			notFound: true,
+		},
+		{
+			name:     "wrapped driver error",
+			err:      fmt.Errorf("query failed: %w", &mysql.MySQLError{Number: 1146, Message: "Table 't1' doesn't exist"}),
+			notFound: true,
+		},
+		{
+			name:     "cannot user code",
+			err:      &mysql.MySQLError{Number: 1396, Message: "Operation CREATE USER failed for 'jack'@'%'"},
+			notFound: false,
+		},
+		{
+			name:     "access denied",
+			err:      &mysql.MySQLError{Number: 1045, Message: "Access denied for user 'root'"},
+			notFound: false,
+		},
+		{
+			name:     "non driver error",
+			err:      errors.New("connection refused"),
+			notFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := wrapNotFound(tt.err, "resource group", "rg1")
+			if IsNotFound(err) != tt.notFound {
+				t.Errorf("IsNotFound(wrapNotFound(%v)) = %v, want %v", tt.err, IsNotFound(err), tt.notFound)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("wrapNotFound(%v) does not wrap the original error", tt.err)
+			}
+		})
+	}
+}
+
+func TestWrapUserNotFound(t *testing.T) {
+	missing := &mysql.MySQLError{Number: 1396, Message: "Operation DROP USER failed for 'jack'@'%'"}
+	if err := wrapUserNotFound(missing, "user", "jack"); !IsNotFound(err) || !errors.Is(err, missing) {
+		t.Errorf("wrapUserNotFound(%v) = %v, want not found", missing, err)
+	}
+
+	denied := &mysql.MySQLError{Number: 1045, Message: "Access denied for user 'root'"}
+	if err := wrapUserNotFound(denied, "user", "jack"); IsNotFound(err) {
+		t.Errorf("wrapUserNotFound(%v) = %v, want the original error", denied, err)
+	}
+	if err := wrapUserNotFound(nil, "user", "jack"); err != nil {
+		t.Errorf("wrapUserNotFound(nil) = %v, want nil", err)
+	}
+}
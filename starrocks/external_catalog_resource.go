@@ -0,0 +1,407 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &externalCatalogResource{}
+	_ resource.ResourceWithConfigure      = &externalCatalogResource{}
+	_ resource.ResourceWithImportState    = &externalCatalogResource{}
+	_ resource.ResourceWithValidateConfig = &externalCatalogResource{}
+)
+
+func NewExternalCatalogResource() resource.Resource {
+	return &externalCatalogResource{}
+}
+
+type externalCatalogResource struct {
+	client *Client
+}
+
+type externalCatalogResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Comment    types.String `tfsdk:"comment"`
+	Type       types.String `tfsdk:"type"`
+	Metastore  types.Object `tfsdk:"metastore"`
+	Glue       types.Object `tfsdk:"glue"`
+	S3         types.Object `tfsdk:"s3"`
+	GCS        types.Object `tfsdk:"gcs"`
+	Azure      types.Object `tfsdk:"azure"`
+	JDBC       types.Object `tfsdk:"jdbc"`
+	Properties types.Map    `tfsdk:"properties"`
+}
+
+// blocks returns the typed credential and connection blocks by name.
+func (m *externalCatalogResourceModel) blocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"glue":  &m.Glue,
+		"s3":    &m.S3,
+		"gcs":   &m.GCS,
+		"azure": &m.Azure,
+		"jdbc":  &m.JDBC,
+	}
+}
+
+// metastoreType returns the configured metastore type, if any.
+func (m *externalCatalogResourceModel) metastoreType() string {
+	if m.Metastore.IsNull() || m.Metastore.IsUnknown() {
+		return ""
+	}
+	if v, ok := m.Metastore.Attributes()["type"].(types.String); ok {
+		return v.ValueString()
+	}
+	return ""
+}
+
+// catalogProperties renders the typed blocks and extra properties as the
+// PROPERTIES of CREATE EXTERNAL CATALOG.
+func (m *externalCatalogResourceModel) catalogProperties() map[string]string {
+	props := mapValues(m.Properties)
+	if props == nil {
+		props = make(map[string]string)
+	}
+	props["type"] = m.Type.ValueString()
+
+	catalogBlockProperties(m.Metastore, metastoreFields(m.Type.ValueString(), m.metastoreType()), props)
+	for name, obj := range m.blocks() {
+		catalogBlockProperties(*obj, catalogBlockFields[name], props)
+	}
+	return props
+}
+
+func (r *externalCatalogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_catalog"
+}
+
+// catalogBlockSchema builds the schema of a typed catalog block. Changing an
+// immutable block forces a new catalog.
+func catalogBlockSchema(name string) schema.SingleNestedAttribute {
+	fields := catalogBlockFields[name]
+	attrs := make(map[string]schema.Attribute, len(fields))
+	for _, f := range fields {
+		if f.Bool {
+			attrs[f.Attr] = schema.BoolAttribute{Optional: true}
+		} else {
+			attrs[f.Attr] = schema.StringAttribute{Optional: true, Sensitive: f.Sensitive}
+		}
+	}
+
+	block := schema.SingleNestedAttribute{Optional: true, Attributes: attrs}
+	if immutableCatalogBlocks[name] {
+		block.PlanModifiers = []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				requiresReplaceCatalogBlock(fields),
+				"Changing this block forces a new catalog.",
+				"Changing this block forces a new catalog.",
+			),
+		}
+	}
+	return block
+}
+
+// requiresReplaceCatalogBlock forces a new catalog when an attribute of an
+// immutable block changes. Secrets missing from state, as after an import,
+// are recorded without replacing the catalog.
+func requiresReplaceCatalogBlock(fields []catalogField) objectplanmodifier.RequiresReplaceIfFunc {
+	return func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+		if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+			resp.RequiresReplace = true
+			return
+		}
+
+		stateAttrs, planAttrs := req.StateValue.Attributes(), req.PlanValue.Attributes()
+		for _, f := range fields {
+			if f.Sensitive && stateAttrs[f.Attr].IsNull() {
+				continue
+			}
+			if !stateAttrs[f.Attr].Equal(planAttrs[f.Attr]) {
+				resp.RequiresReplace = true
+				return
+			}
+		}
+	}
+}
+
+func (r *externalCatalogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks external catalog for Hive, Iceberg, Hudi, Delta Lake, JDBC or Paimon.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(catalogTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metastore": schema.SingleNestedAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("hive", "glue", "rest", "jdbc", "filesystem", "dlf"),
+						},
+					},
+					"uri": schema.StringAttribute{
+						Optional: true,
+					},
+					"warehouse": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"glue":  catalogBlockSchema("glue"),
+			"s3":    catalogBlockSchema("s3"),
+			"gcs":   catalogBlockSchema("gcs"),
+			"azure": catalogBlockSchema("azure"),
+			"jdbc":  catalogBlockSchema("jdbc"),
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+		},
+	}
+}
+
+func (r *externalCatalogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config externalCatalogResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Type.IsUnknown() {
+		return
+	}
+	catalogType := config.Type.ValueString()
+
+	if catalogType == catalogTypeJDBC {
+		if config.JDBC.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("jdbc"), "Missing JDBC Connection", "JDBC catalogs require a jdbc block.")
+		}
+		if !config.Metastore.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("metastore"), "Unexpected Metastore", "JDBC catalogs do not use a metastore.")
+		}
+	} else {
+		if !config.JDBC.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("jdbc"), "Unexpected JDBC Connection", "The jdbc block is only supported for JDBC catalogs.")
+		}
+		if config.Metastore.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("metastore"), "Missing Metastore", fmt.Sprintf("%s catalogs require a metastore block.", catalogType))
+		}
+	}
+
+	if !config.Metastore.IsNull() && !config.Metastore.IsUnknown() {
+		if v, ok := config.Metastore.Attributes()["warehouse"].(types.String); ok && !v.IsNull() &&
+			catalogType != catalogTypeIceberg && catalogType != catalogTypePaimon {
+			resp.Diagnostics.AddAttributeError(path.Root("metastore").AtName("warehouse"), "Unsupported Warehouse", "warehouse is only supported for Iceberg and Paimon catalogs.")
+		}
+	}
+	if !config.Glue.IsNull() && config.metastoreType() != "glue" && !config.Metastore.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("glue"), "Unexpected Glue Settings", "The glue block requires metastore type glue.")
+	}
+
+	// Properties set by typed blocks cannot be repeated in properties.
+	managed := map[string]bool{"type": true}
+	for _, f := range metastoreFields(catalogType, config.metastoreType()) {
+		managed[f.Key] = true
+	}
+	for _, fields := range catalogBlockFields {
+		for _, f := range fields {
+			managed[f.Key] = true
+		}
+	}
+	for k := range mapValues(config.Properties) {
+		if managed[k] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("properties"),
+				"Conflicting Catalog Property",
+				fmt.Sprintf("Property %q is managed by a dedicated attribute and cannot be set in properties.", k),
+			)
+		}
+	}
+}
+
+func (r *externalCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan externalCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateExternalCatalog(plan.Name.ValueString(), plan.Comment, plan.catalogProperties()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create External Catalog", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *externalCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state externalCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := r.client.GetExternalCatalog(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading external catalog", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(catalog, false)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refresh updates the model from the server. Blocks are only read when set
+// in the model, unless all is set as for imports. Secrets are kept from the
+// model as the server masks them.
+func (m *externalCatalogResourceModel) refresh(catalog *ExternalCatalog, all bool) diag.Diagnostics {
+	props := make(map[string]string, len(catalog.Properties))
+	for k, v := range catalog.Properties {
+		if !isMaskedValue(v) {
+			props[k] = v
+		}
+	}
+
+	m.Comment = catalog.Comment
+	if t := props["type"]; t != "" {
+		m.Type = types.StringValue(strings.ToLower(t))
+	}
+	catalogType := m.Type.ValueString()
+
+	metastoreType := m.metastoreType()
+	fields := metastoreFields(catalogType, metastoreType)
+	if len(fields) > 0 {
+		if t, ok := props[fields[0].Key]; ok {
+			metastoreType = t
+			fields = metastoreFields(catalogType, metastoreType)
+		}
+	}
+	if !m.Metastore.IsNull() || (all && hasCatalogProperties(fields, props)) {
+		m.Metastore = catalogBlockFromProperties(m.Metastore, metastoreAttrTypes, fields, props)
+	}
+
+	for name, obj := range m.blocks() {
+		fields := catalogBlockFields[name]
+		if !obj.IsNull() || (all && hasCatalogProperties(fields, props)) {
+			*obj = catalogBlockFromProperties(*obj, catalogBlockAttrTypes(fields), fields, props)
+		}
+	}
+
+	var diags diag.Diagnostics
+	m.Properties, diags = trackedProperties(m.Properties, stringMap(props))
+	return diags
+}
+
+func (r *externalCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state externalCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to immutable properties force a new catalog; the only ones left
+	// are secrets recorded after an import, which are kept as is.
+	changed := changedProperties(state.catalogProperties(), plan.catalogProperties())
+	for k := range changed {
+		if !catalogPropertyMutable(k) {
+			delete(changed, k)
+		}
+	}
+	if err := r.client.SetCatalogProperties(plan.Name.ValueString(), changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Alter External Catalog", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *externalCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state externalCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropExternalCatalog(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop External Catalog", err.Error())
+	}
+}
+
+func (r *externalCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	catalog, err := r.client.GetExternalCatalog(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing external catalog", err.Error())
+		return
+	}
+
+	state := externalCatalogResourceModel{
+		Name:       catalog.Name,
+		Type:       types.StringNull(),
+		Metastore:  types.ObjectNull(metastoreAttrTypes),
+		Properties: types.MapNull(types.StringType),
+	}
+	for name, obj := range state.blocks() {
+		*obj = types.ObjectNull(catalogBlockAttrTypes(catalogBlockFields[name]))
+	}
+	resp.Diagnostics.Append(state.refresh(catalog, true)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *externalCatalogResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
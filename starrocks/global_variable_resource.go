@@ -0,0 +1,187 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &globalVariableResource{}
+	_ resource.ResourceWithConfigure   = &globalVariableResource{}
+	_ resource.ResourceWithImportState = &globalVariableResource{}
+)
+
+// variableNamePattern matches system variable names, which are used unquoted
+// in SET statements.
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func NewGlobalVariableResource() resource.Resource {
+	return &globalVariableResource{}
+}
+
+type globalVariableResource struct {
+	client *Client
+}
+
+type globalVariableResourceModel struct {
+	Name          types.String  `tfsdk:"name"`
+	Value         VariableValue `tfsdk:"value"`
+	OriginalValue types.String  `tfsdk:"original_value"`
+}
+
+func (r *globalVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_variable"
+}
+
+func (r *globalVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the global value of a StarRocks system variable, restoring the original value on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(variableNamePattern, "must be a system variable name, e.g. \"query_timeout\""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				CustomType: VariableValueType{},
+				Required:   true,
+			},
+			"original_value": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *globalVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	// The value before the first apply is restored on destroy.
+	original, err := r.client.GetGlobalVariable(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading global variable", err.Error())
+		return
+	}
+	plan.OriginalValue = original
+
+	if err := r.client.SetGlobalVariable(name, plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to Set Global Variable", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.GetGlobalVariable(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading global variable", err.Error())
+		return
+	}
+	// VariableValue keeps the configured spelling when the value is the same.
+	state.Value = VariableValue{StringValue: value}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *globalVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan globalVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SetGlobalVariable(plan.Name.ValueString(), plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Unable to Set Global Variable", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported variables have no original value and go back to the server
+	// default.
+	name := state.Name.ValueString()
+	var err error
+	if state.OriginalValue.IsNull() {
+		err = r.client.ResetGlobalVariable(name)
+	} else {
+		err = r.client.SetGlobalVariable(name, state.OriginalValue.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Restore Global Variable", err.Error())
+	}
+}
+
+func (r *globalVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !variableNamePattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a system variable name, got: %q", req.ID))
+		return
+	}
+
+	value, err := r.client.GetGlobalVariable(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing global variable", err.Error())
+		return
+	}
+
+	state := globalVariableResourceModel{
+		Name:          types.StringValue(req.ID),
+		Value:         VariableValue{StringValue: value},
+		OriginalValue: types.StringNull(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *globalVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
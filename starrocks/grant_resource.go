@@ -0,0 +1,400 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &grantResource{}
+	_ resource.ResourceWithConfigure        = &grantResource{}
+	_ resource.ResourceWithImportState      = &grantResource{}
+	_ resource.ResourceWithConfigValidators = &grantResource{}
+	_ resource.ResourceWithValidateConfig   = &grantResource{}
+)
+
+func NewGrantResource() resource.Resource {
+	return &grantResource{}
+}
+
+type grantResource struct {
+	client *Client
+}
+
+type grantResourceModel struct {
+	Role            types.String `tfsdk:"role"`
+	User            types.String `tfsdk:"user"`
+	ObjectType      types.String `tfsdk:"object_type"`
+	Database        types.String `tfsdk:"database"`
+	ObjectName      types.String `tfsdk:"object_name"`
+	Privileges      types.Set    `tfsdk:"privileges"`
+	WithGrantOption types.Bool   `tfsdk:"with_grant_option"`
+}
+
+func (m *grantResourceModel) grantee() (Grantee, error) {
+	if !m.Role.IsNull() {
+		return Grantee{Role: m.Role.ValueString()}, nil
+	}
+
+	user, host, err := parseUserIdentity(m.User.ValueString())
+	if err != nil {
+		return Grantee{}, err
+	}
+	return Grantee{User: user, Host: host}, nil
+}
+
+func (m *grantResourceModel) object() GrantObject {
+	return GrantObject{
+		Type:     m.ObjectType.ValueString(),
+		Database: m.Database.ValueString(),
+		Name:     m.ObjectName.ValueString(),
+	}
+}
+
+func (r *grantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant"
+}
+
+func (r *grantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages privileges of a StarRocks role or user on an object.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(grantObjectTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"with_grant_option": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *grantResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("role"),
+			path.MatchRoot("user"),
+		),
+	}
+}
+
+func (r *grantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config grantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.User.IsNull() && !config.User.IsUnknown() {
+		if _, _, err := parseUserIdentity(config.User.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("user"), "Invalid User Identity", err.Error())
+		}
+	}
+
+	if config.ObjectType.IsUnknown() || config.Database.IsUnknown() || config.ObjectName.IsUnknown() {
+		return
+	}
+	obj := config.object()
+	if err := obj.validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid Grant Object", err.Error())
+		return
+	}
+
+	if config.Privileges.IsUnknown() {
+		return
+	}
+	allowed := make(map[string]bool)
+	for _, p := range grantPrivileges[obj.Type] {
+		allowed[p] = true
+	}
+	for _, p := range normalizePrivileges(stringSetValues(config.Privileges)) {
+		if p != "ALL" && !allowed[p] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("privileges"),
+				"Invalid Privilege",
+				fmt.Sprintf("%s is not a privilege of %s objects. Valid privileges are ALL, %s.", p, obj.Type, strings.Join(grantPrivileges[obj.Type], ", ")),
+			)
+		}
+	}
+}
+
+func (r *grantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grantee, err := plan.grantee()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	err = r.client.GrantPrivileges(grantee, plan.object(), stringSetValues(plan.Privileges), plan.WithGrantOption.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Privileges", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *grantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grantee, err := state.grantee()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	privileges, err := r.client.GetGrantedPrivileges(grantee, state.object(), state.WithGrantOption.ValueBool())
+	if IsNotFound(err) || (err == nil && len(privileges) == 0) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading grant", err.Error())
+		return
+	}
+
+	// Keep the configured spelling, including ALL, when it grants the same
+	// privileges as the server reports.
+	objectType := state.ObjectType.ValueString()
+	if !reflect.DeepEqual(expandPrivileges(objectType, stringSetValues(state.Privileges)), expandPrivileges(objectType, privileges)) {
+		state.Privileges = stringSet(privileges)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *grantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grantee, err := plan.grantee()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	objectType := plan.ObjectType.ValueString()
+	added, removed := diffStrings(
+		expandPrivileges(objectType, stringSetValues(state.Privileges)),
+		expandPrivileges(objectType, stringSetValues(plan.Privileges)),
+	)
+
+	if err := r.client.GrantPrivileges(grantee, plan.object(), added, plan.WithGrantOption.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Privileges", err.Error())
+		return
+	}
+	if err := r.client.RevokePrivileges(grantee, plan.object(), removed); err != nil {
+		resp.Diagnostics.AddError("Unable to Revoke Privileges", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *grantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grantee, err := state.grantee()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	if err := r.client.RevokePrivileges(grantee, state.object(), stringSetValues(state.Privileges)); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Revoke Privileges", err.Error())
+	}
+}
+
+func (r *grantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, err := parseGrantImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected role|<role>|<object type>|<object> or user|<user identity>|<object type>|<object>: %v", err),
+		)
+		return
+	}
+
+	grantee, err := state.grantee()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	// Prefer the grant without grant option when both exist.
+	for _, grantOption := range []bool{false, true} {
+		privileges, err := r.client.GetGrantedPrivileges(grantee, state.object(), grantOption)
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing grant", err.Error())
+			return
+		}
+		if len(privileges) > 0 {
+			state.Privileges = stringSet(privileges)
+			state.WithGrantOption = types.BoolValue(grantOption)
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error importing grant", fmt.Sprintf("no privileges granted to %s on %s", grantee, state.object()))
+}
+
+// parseGrantImportID parses an import ID such as role|analyst|TABLE|db.tbl.
+// The object is omitted for SYSTEM, "db" for DATABASE, "db.name" for objects
+// in a database, and the object name otherwise. Any part can be "*".
+func parseGrantImportID(id string) (*grantResourceModel, error) {
+	parts := strings.Split(id, "|")
+	if len(parts) < 3 || len(parts) > 4 {
+		return nil, fmt.Errorf("got %q", id)
+	}
+
+	state := &grantResourceModel{
+		Role:            types.StringNull(),
+		User:            types.StringNull(),
+		ObjectType:      types.StringValue(strings.ToUpper(parts[2])),
+		Database:        types.StringNull(),
+		ObjectName:      types.StringNull(),
+		WithGrantOption: types.BoolValue(false),
+	}
+
+	switch strings.ToLower(parts[0]) {
+	case "role":
+		state.Role = types.StringValue(parts[1])
+	case "user":
+		name, host, err := parseUserIdentity(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		state.User = types.StringValue(userIdentity(name, host))
+	default:
+		return nil, fmt.Errorf("grantee kind must be role or user, got %q", parts[0])
+	}
+
+	obj := GrantObject{Type: state.ObjectType.ValueString()}
+	if len(parts) == 4 {
+		switch {
+		case obj.Type == grantObjectDatabase:
+			obj.Database = parts[3]
+		case obj.inDatabase():
+			db, name, ok := strings.Cut(parts[3], ".")
+			if !ok {
+				return nil, fmt.Errorf("%s objects must be given as <database>.<name>", obj.Type)
+			}
+			obj.Database, obj.Name = db, name
+		default:
+			obj.Name = parts[3]
+		}
+	}
+	if err := obj.validate(); err != nil {
+		return nil, err
+	}
+
+	if obj.Database != "" {
+		state.Database = types.StringValue(obj.Database)
+	}
+	if obj.Name != "" {
+		state.ObjectName = types.StringValue(obj.Name)
+	}
+	return state, nil
+}
+
+func (r *grantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,453 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &materializedViewResource{}
+	_ resource.ResourceWithConfigure      = &materializedViewResource{}
+	_ resource.ResourceWithImportState    = &materializedViewResource{}
+	_ resource.ResourceWithValidateConfig = &materializedViewResource{}
+)
+
+func NewMaterializedViewResource() resource.Resource {
+	return &materializedViewResource{}
+}
+
+type materializedViewResource struct {
+	client *Client
+}
+
+type materializedViewResourceModel struct {
+	Database     types.String  `tfsdk:"database"`
+	Name         types.String  `tfsdk:"name"`
+	Query        SQLQueryValue `tfsdk:"query"`
+	Comment      types.String  `tfsdk:"comment"`
+	Refresh      types.Object  `tfsdk:"refresh"`
+	PartitionBy  types.String  `tfsdk:"partition_by"`
+	Distribution types.Object  `tfsdk:"distribution"`
+	OrderBy      types.List    `tfsdk:"order_by"`
+	Properties   types.Map     `tfsdk:"properties"`
+	Active       types.Bool    `tfsdk:"active"`
+}
+
+func (m *materializedViewResourceModel) materializedView() *MaterializedView {
+	return &MaterializedView{
+		Database:     m.Database,
+		Name:         m.Name,
+		Query:        m.Query.StringValue,
+		Comment:      m.Comment,
+		Refresh:      refreshFromObject(m.Refresh),
+		PartitionBy:  m.PartitionBy,
+		Distribution: distributionFromObject(m.Distribution),
+		OrderBy:      m.OrderBy,
+		Properties:   m.Properties,
+		Active:       m.Active,
+	}
+}
+
+// requiresReplaceMaterializedViewQuery forces a new materialized view when
+// the query changes, unless it was imported without one.
+func requiresReplaceMaterializedViewQuery(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var query SQLQueryValue
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("query"), &query)...)
+	resp.RequiresReplace = !query.IsNull()
+}
+
+func (r *materializedViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_materialized_view"
+}
+
+func (r *materializedViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks asynchronous materialized view.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				CustomType: SQLQueryType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceMaterializedViewQuery,
+						"Changing the query forces a new materialized view.",
+						"Changing the query forces a new materialized view.",
+					),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"refresh": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(refreshAsync, refreshManual),
+						},
+					},
+					"moment": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(refreshImmediate, refreshDeferred),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"start": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"interval": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(refreshIntervalPattern, "must be a number followed by SECOND, MINUTE, HOUR, DAY, WEEK, MONTH or YEAR, e.g. \"1 DAY\""),
+						},
+					},
+				},
+			},
+			"partition_by": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"distribution": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(distributionHash, distributionRandom),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"columns": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
+					},
+					"buckets": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplaceIfConfigured(),
+						},
+					},
+				},
+			},
+			"order_by": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *materializedViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config materializedViewResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if refresh := refreshFromObject(config.Refresh); refresh != nil && !refresh.Type.IsUnknown() {
+		p := path.Root("refresh")
+		if refresh.Type.ValueString() != refreshAsync && (!refresh.Start.IsNull() || !refresh.Interval.IsNull()) {
+			resp.Diagnostics.AddAttributeError(p, "Invalid Refresh", "start and interval are only supported with ASYNC refresh.")
+		}
+		if !refresh.Start.IsNull() && refresh.Interval.IsNull() {
+			resp.Diagnostics.AddAttributeError(p.AtName("start"), "Invalid Refresh", "start requires interval.")
+		}
+	}
+
+	if d := distributionFromObject(config.Distribution); d != nil && !d.Type.IsUnknown() && !d.Columns.IsUnknown() {
+		p := path.Root("distribution").AtName("columns")
+		switch {
+		case d.Type.ValueString() == distributionHash && d.Columns.IsNull():
+			resp.Diagnostics.AddAttributeError(p, "Missing Distribution Columns", "HASH distribution requires columns.")
+		case d.Type.ValueString() == distributionRandom && !d.Columns.IsNull():
+			resp.Diagnostics.AddAttributeError(p, "Unexpected Distribution Columns", "RANDOM distribution does not take columns.")
+		}
+	}
+}
+
+func (r *materializedViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan materializedViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, name := plan.Database.ValueString(), plan.Name.ValueString()
+	if err := r.client.CreateMaterializedView(plan.materializedView()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Materialized View", err.Error())
+		return
+	}
+
+	if !plan.Active.ValueBool() {
+		if err := r.client.SetMaterializedViewActive(db, name, false); err != nil {
+			resp.Diagnostics.AddError("Unable to Deactivate Materialized View", err.Error())
+			return
+		}
+	}
+
+	// Fill in the bucket count chosen by the server.
+	if d := distributionFromObject(plan.Distribution); d != nil && d.Buckets.IsUnknown() {
+		mv, err := r.client.GetMaterializedView(db, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading materialized view", err.Error())
+			return
+		}
+		d.Buckets = types.Int64Null()
+		if mv.Distribution != nil {
+			d.Buckets = mv.Distribution.Buckets
+		}
+		plan.Distribution = distributionToObject(d)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *materializedViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state materializedViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mv, err := r.client.GetMaterializedView(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading materialized view", err.Error())
+		return
+	}
+
+	// The stored definition is the FE's analyzed query, so the configured
+	// query is kept.
+	state.Comment = mv.Comment
+	state.Active = mv.Active
+
+	// The refresh moment only applies at creation and is kept from state.
+	if refresh := refreshFromObject(state.Refresh); refresh != nil && mv.Refresh != nil {
+		refresh.Type = mv.Refresh.Type
+		// A start time is reported with seconds even when configured
+		// without them.
+		if !strings.HasPrefix(mv.Refresh.Start.ValueString(), refresh.Start.ValueString()) || mv.Refresh.Start.IsNull() {
+			refresh.Start = mv.Refresh.Start
+		}
+		if !strings.EqualFold(refresh.Interval.ValueString(), mv.Refresh.Interval.ValueString()) {
+			refresh.Interval = mv.Refresh.Interval
+		}
+		state.Refresh = refreshToObject(refresh)
+	}
+
+	if !sameExpression(state.PartitionBy.ValueString(), mv.PartitionBy.ValueString()) {
+		state.PartitionBy = mv.PartitionBy
+	}
+
+	// Distribution and sort key are only tracked when configured; StarRocks
+	// picks them otherwise.
+	if d := distributionFromObject(state.Distribution); d != nil && mv.Distribution != nil {
+		d.Type = mv.Distribution.Type
+		d.Columns = mv.Distribution.Columns
+		if !mv.Distribution.Buckets.IsNull() {
+			d.Buckets = mv.Distribution.Buckets
+		}
+		state.Distribution = distributionToObject(d)
+	}
+	if !state.OrderBy.IsNull() {
+		state.OrderBy = mv.OrderBy
+	}
+
+	var diags diag.Diagnostics
+	state.Properties, diags = trackedProperties(state.Properties, mv.Properties)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *materializedViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state materializedViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, name := plan.Database.ValueString(), plan.Name.ValueString()
+
+	// Without a refresh block the materialized view goes back to manual
+	// refresh.
+	planRefresh, stateRefresh := refreshFromObject(plan.Refresh), refreshFromObject(state.Refresh)
+	if planRefresh == nil && stateRefresh != nil {
+		manual := &MaterializedViewRefresh{
+			Type:     types.StringValue(refreshManual),
+			Moment:   types.StringNull(),
+			Start:    types.StringNull(),
+			Interval: types.StringNull(),
+		}
+		if err := r.client.SetMaterializedViewRefresh(db, name, manual); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Materialized View Refresh", err.Error())
+			return
+		}
+	}
+	if planRefresh != nil && (stateRefresh == nil ||
+		!planRefresh.Type.Equal(stateRefresh.Type) ||
+		!planRefresh.Start.Equal(stateRefresh.Start) ||
+		!planRefresh.Interval.Equal(stateRefresh.Interval)) {
+		if err := r.client.SetMaterializedViewRefresh(db, name, planRefresh); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Materialized View Refresh", err.Error())
+			return
+		}
+	}
+
+	changed := changedProperties(mapValues(state.Properties), mapValues(plan.Properties))
+	if err := r.client.SetMaterializedViewProperties(db, name, changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Set Materialized View Properties", err.Error())
+		return
+	}
+
+	if !plan.Active.Equal(state.Active) {
+		if err := r.client.SetMaterializedViewActive(db, name, plan.Active.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Materialized View State", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *materializedViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state materializedViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropMaterializedView(state.Database.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Materialized View", err.Error())
+	}
+}
+
+func (r *materializedViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<materialized view>, got: %q", req.ID))
+		return
+	}
+
+	mv, err := r.client.GetMaterializedView(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing materialized view", err.Error())
+		return
+	}
+
+	state := materializedViewResourceModel{
+		Database:     mv.Database,
+		Name:         mv.Name,
+		Query:        NewSQLQueryNull(),
+		Comment:      mv.Comment,
+		Refresh:      refreshToObject(mv.Refresh),
+		PartitionBy:  mv.PartitionBy,
+		Distribution: distributionToObject(mv.Distribution),
+		OrderBy:      mv.OrderBy,
+		Properties:   types.MapNull(types.StringType),
+		Active:       mv.Active,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *materializedViewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,130 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = PercentageType{}
+	_ basetypes.StringValuableWithSemanticEquals = PercentageValue{}
+)
+
+// PercentageType is a string type for percentage-valued properties such as
+// mem_limit. StarRocks normalizes these values when storing them (e.g. "80%"
+// is reported back as "80.0%"), so values are compared numerically.
+type PercentageType struct {
+	basetypes.StringType
+}
+
+func (t PercentageType) String() string {
+	return "PercentageType"
+}
+
+func (t PercentageType) Equal(o attr.Type) bool {
+	other, ok := o.(PercentageType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t PercentageType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PercentageValue{StringValue: in}, nil
+}
+
+func (t PercentageType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t PercentageType) ValueType(_ context.Context) attr.Value {
+	return PercentageValue{}
+}
+
+// PercentageValue is the value of a PercentageType attribute.
+type PercentageValue struct {
+	basetypes.StringValue
+}
+
+func NewPercentageValue(value string) PercentageValue {
+	return PercentageValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewPercentageNull() PercentageValue {
+	return PercentageValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewPercentageUnknown() PercentageValue {
+	return PercentageValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v PercentageValue) Type(_ context.Context) attr.Type {
+	return PercentageType{}
+}
+
+func (v PercentageValue) Equal(o attr.Value) bool {
+	other, ok := o.(PercentageValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values denote the same
+// percentage, e.g. "80%" and "80.0%".
+func (v PercentageValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PercentageValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return samePercentage(v.ValueString(), newValue.ValueString()), diags
+}
+
+// samePercentage reports whether two strings denote the same percentage.
+// Strings that do not parse are compared as written.
+func samePercentage(a, b string) bool {
+	x, errA := parsePercentage(a)
+	y, errB := parsePercentage(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return x == y
+}
+
+// parsePercentage parses a percentage such as "80%" or "80.0%" into its
+// numeric value.
+func parsePercentage(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasSuffix(s, "%") {
+		return 0, fmt.Errorf("percentage %q must end with %%", s)
+	}
+	return strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, "%")), 64)
+}
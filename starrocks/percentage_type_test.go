@@ -0,0 +1,43 @@
+package starrocks
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPercentageValue_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		new   string
+		equal bool
+	}{
+		{name: "identical", prior: "80%", new: "80%", equal: true},
+		{name: "server decimal", prior: "80%", new: "80.0%", equal: true},
+		{name: "surrounding spaces", prior: " 50 %", new: "50.00%", equal: true},
+		{name: "different value", prior: "80%", new: "50.0%", equal: false},
+		{name: "missing percent sign", prior: "80", new: "80.0%", equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewPercentageValue(tt.prior).StringSemanticEquals(context.Background(), NewPercentageValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.equal {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.new, equal, tt.equal)
+			}
+		})
+	}
+}
+
+func TestPercentageType_ValueType(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := (PercentageType{}).ValueType(ctx).(PercentageValue); !ok {
+		t.Error("ValueType() should return a PercentageValue")
+	}
+	if !NewPercentageValue("80%").Type(ctx).Equal(PercentageType{}) {
+		t.Error("PercentageValue.Type() should be PercentageType")
+	}
+}
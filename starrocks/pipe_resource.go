@@ -0,0 +1,402 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &pipeResource{}
+	_ resource.ResourceWithConfigure      = &pipeResource{}
+	_ resource.ResourceWithImportState    = &pipeResource{}
+	_ resource.ResourceWithValidateConfig = &pipeResource{}
+)
+
+func NewPipeResource() resource.Resource {
+	return &pipeResource{}
+}
+
+type pipeResource struct {
+	client *Client
+}
+
+type pipeResourceModel struct {
+	Database     types.String  `tfsdk:"database"`
+	Name         types.String  `tfsdk:"name"`
+	Query        SQLQueryValue `tfsdk:"query"`
+	Credentials  types.Map     `tfsdk:"credentials"`
+	AutoIngest   types.Bool    `tfsdk:"auto_ingest"`
+	PollInterval types.Int64   `tfsdk:"poll_interval"`
+	BatchSize    types.String  `tfsdk:"batch_size"`
+	BatchFiles   types.Int64   `tfsdk:"batch_files"`
+	Properties   types.Map     `tfsdk:"properties"`
+	Suspended    types.Bool    `tfsdk:"suspended"`
+	Table        types.String  `tfsdk:"table"`
+}
+
+func (m *pipeResourceModel) pipe() *Pipe {
+	return &Pipe{
+		Database:     m.Database,
+		Name:         m.Name,
+		Query:        m.Query.StringValue,
+		Credentials:  mapValues(m.Credentials),
+		AutoIngest:   m.AutoIngest,
+		PollInterval: m.PollInterval,
+		BatchSize:    m.BatchSize,
+		BatchFiles:   m.BatchFiles,
+		Properties:   m.Properties,
+		Suspended:    m.Suspended,
+	}
+}
+
+// applyComputed fills in computed attributes that were unknown in the plan
+// from the pipe reported by the server.
+func (m *pipeResourceModel) applyComputed(p *Pipe) {
+	m.Table = p.Table
+	if m.AutoIngest.IsUnknown() {
+		m.AutoIngest = p.AutoIngest
+	}
+	if m.PollInterval.IsUnknown() {
+		m.PollInterval = p.PollInterval
+	}
+	if m.BatchSize.IsUnknown() {
+		m.BatchSize = p.BatchSize
+	}
+	if m.BatchFiles.IsUnknown() {
+		m.BatchFiles = p.BatchFiles
+	}
+}
+
+// refresh updates the model from the server. The query and credentials are
+// not reported and keep their values. batch_size may be reported in a
+// different unit, so it is only read when not set.
+func (m *pipeResourceModel) refresh(p *Pipe) diag.Diagnostics {
+	m.Table = p.Table
+	m.Suspended = p.Suspended
+	if !p.AutoIngest.IsNull() {
+		m.AutoIngest = p.AutoIngest
+	}
+	if !p.PollInterval.IsNull() {
+		m.PollInterval = p.PollInterval
+	}
+	if !p.BatchFiles.IsNull() {
+		m.BatchFiles = p.BatchFiles
+	}
+	if m.BatchSize.IsNull() {
+		m.BatchSize = p.BatchSize
+	}
+
+	var diags diag.Diagnostics
+	m.Properties, diags = trackedProperties(m.Properties, p.Properties)
+	return diags
+}
+
+// pipeImported reports whether the pipe was imported and its query has not
+// been applied from configuration yet.
+func pipeImported(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var query SQLQueryValue
+	diags := state.GetAttribute(ctx, path.Root("query"), &query)
+	return query.IsNull(), diags
+}
+
+// requiresReplacePipeQuery forces a new pipe when the query changes, unless
+// the pipe was imported without one.
+func requiresReplacePipeQuery(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	imported, diags := pipeImported(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !imported
+}
+
+// requiresReplacePipeCredentials forces a new pipe when the credentials
+// change, unless the pipe was imported without a query.
+func requiresReplacePipeCredentials(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	imported, diags := pipeImported(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !imported
+}
+
+func (r *pipeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipe"
+}
+
+func (r *pipeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks pipe that continuously loads files from object storage.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				CustomType: SQLQueryType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplacePipeQuery,
+						"Changing the query forces a new pipe.",
+						"Changing the query forces a new pipe.",
+					),
+				},
+			},
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(
+						requiresReplacePipeCredentials,
+						"Changing the credentials forces a new pipe.",
+						"Changing the credentials forces a new pipe.",
+					),
+				},
+			},
+			"auto_ingest": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"poll_interval": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"batch_size": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"batch_files": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+			"suspended": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"table": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pipeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pipeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for k := range mapValues(config.Properties) {
+		switch key := strings.ToUpper(k); key {
+		case pipeAutoIngest, pipePollInterval, pipeBatchSize, pipeBatchFiles:
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Property", fmt.Sprintf("Use the %s attribute instead of the %q property.", strings.ToLower(key), k))
+		}
+	}
+
+	if config.Query.IsUnknown() || config.Credentials.IsUnknown() {
+		return
+	}
+	if _, err := pipeQuery(config.Query.ValueString(), mapValues(config.Credentials)); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("credentials"), "Invalid Attribute Combination", err.Error())
+	}
+}
+
+func (r *pipeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, name := plan.Database.ValueString(), plan.Name.ValueString()
+	if err := r.client.CreatePipe(plan.pipe()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Pipe", err.Error())
+		return
+	}
+
+	if plan.Suspended.ValueBool() {
+		if err := r.client.SetPipeSuspended(database, name, true); err != nil {
+			resp.Diagnostics.AddError("Unable to Suspend Pipe", err.Error())
+			return
+		}
+	}
+
+	p, err := r.client.GetPipe(database, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pipe", err.Error())
+		return
+	}
+	plan.applyComputed(p)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pipeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := r.client.GetPipe(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pipe", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(p)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pipeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state pipeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, name := plan.Database.ValueString(), plan.Name.ValueString()
+
+	changed := changedProperties(pipeProperties(state.pipe()), pipeProperties(plan.pipe()))
+	if err := r.client.SetPipeProperties(database, name, changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Alter Pipe", err.Error())
+		return
+	}
+
+	if !plan.Suspended.Equal(state.Suspended) {
+		if err := r.client.SetPipeSuspended(database, name, plan.Suspended.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Unable to Suspend or Resume Pipe", err.Error())
+			return
+		}
+	}
+
+	p, err := r.client.GetPipe(database, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pipe", err.Error())
+		return
+	}
+	plan.applyComputed(p)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pipeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropPipe(state.Database.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Pipe", err.Error())
+	}
+}
+
+func (r *pipeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<name>, got: %q", req.ID))
+		return
+	}
+
+	p, err := r.client.GetPipe(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing pipe", err.Error())
+		return
+	}
+
+	state := pipeResourceModel{
+		Database:    p.Database,
+		Name:        p.Name,
+		Query:       NewSQLQueryNull(),
+		Credentials: types.MapNull(types.StringType),
+		BatchSize:   types.StringNull(),
+		Properties:  types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(state.refresh(p)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pipeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
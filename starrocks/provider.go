@@ -3,16 +3,44 @@ package starrocks
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ provider.Provider = &starrocksProvider{}
 
+// Environment variables used when the corresponding provider attribute is
+// not set.
+const (
+	envHost     = "STARROCKS_HOST"
+	envPort     = "STARROCKS_PORT"
+	envUser     = "STARROCKS_USER"
+	envPassword = "STARROCKS_PASSWORD"
+)
+
+// defaultPort is the FE MySQL protocol port.
+const defaultPort int64 = 9030
+
+var providerEnvVars = map[string]string{
+	"host":     envHost,
+	"port":     envPort,
+	"username": envUser,
+	"password": envPassword,
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &starrocksProvider{version: version}
@@ -24,10 +52,24 @@ type starrocksProvider struct {
 }
 
 type starrocksProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Port     types.Int64  `tfsdk:"port"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Host           types.String      `tfsdk:"host"`
+	Port           types.Int64       `tfsdk:"port"`
+	Username       types.String      `tfsdk:"username"`
+	Password       types.String      `tfsdk:"password"`
+	TLS            *providerTLSModel `tfsdk:"tls"`
+	ConnectTimeout types.String      `tfsdk:"connect_timeout"`
+	ReadTimeout    types.String      `tfsdk:"read_timeout"`
+	WriteTimeout   types.String      `tfsdk:"write_timeout"`
+	DSNParams      types.Map         `tfsdk:"dsn_params"`
+}
+
+type providerTLSModel struct {
+	Mode               types.String `tfsdk:"mode"`
+	CACert             types.String `tfsdk:"ca_cert"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ServerName         types.String `tfsdk:"server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *starrocksProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,18 +81,62 @@ func (p *starrocksProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 			},
 			"port": schema.Int64Attribute{
-				Required: true,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"username": schema.StringAttribute{
-				Required: true,
+				Optional: true,
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
+			"tls": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(TLSModeDisabled, TLSModePreferred, TLSModeRequired),
+						},
+					},
+					"ca_cert":     schema.StringAttribute{Optional: true},
+					"client_cert": schema.StringAttribute{Optional: true},
+					"client_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"server_name":          schema.StringAttribute{Optional: true},
+					"insecure_skip_verify": schema.BoolAttribute{Optional: true},
+				},
+			},
+			"connect_timeout": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{durationValidator{}},
+			},
+			"read_timeout": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{durationValidator{}},
+			},
+			"write_timeout": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{durationValidator{}},
+			},
+			"dsn_params": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(dsnParamPattern, "must be a connection parameter name, e.g. \"charset\""),
+						stringvalidator.NoneOf(reservedDSNParams...),
+					),
+				},
+			},
 		},
 	}
 }
@@ -63,22 +149,157 @@ func (p *starrocksProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	host := config.Host.ValueString()
-	port := fmt.Sprintf("%d", config.Port.ValueInt64())
-	username := config.Username.ValueString()
-	password := config.Password.ValueString()
+	for _, setting := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"host", config.Host},
+		{"port", config.Port},
+		{"username", config.Username},
+		{"password", config.Password},
+	} {
+		if setting.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(setting.name),
+				"Unknown StarRocks Connection Setting",
+				fmt.Sprintf("The provider cannot create the StarRocks client as there is an unknown configuration value for %q. "+
+					"Either set it to a known value or use the %s environment variable.", setting.name, providerEnvVars[setting.name]),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host := stringFromConfigOrEnv(config.Host, envHost)
+	username := stringFromConfigOrEnv(config.Username, envUser)
+	password := stringFromConfigOrEnv(config.Password, envPassword)
+
+	port := defaultPort
+	if !config.Port.IsNull() {
+		port = config.Port.ValueInt64()
+	} else if v := os.Getenv(envPort); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || parsed <= 0 || parsed > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port"),
+				"Invalid StarRocks Port",
+				fmt.Sprintf("The %s environment variable must be a port number, got: %q", envPort, v),
+			)
+		} else {
+			port = parsed
+		}
+	}
 
-	hostPort := fmt.Sprintf("%s:%s", host, port)
-	c, err := NewClient(hostPort, username, password)
+	if host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Missing StarRocks Host",
+			fmt.Sprintf("The provider cannot create the StarRocks client as the FE host is not set. "+
+				"Set the host attribute in the provider configuration or the %s environment variable.", envHost),
+		)
+	}
+	if username == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing StarRocks Username",
+			fmt.Sprintf("The provider cannot create the StarRocks client as the username is not set. "+
+				"Set the username attribute in the provider configuration or the %s environment variable.", envUser),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostPort := fmt.Sprintf("%s:%d", host, port)
+	cfg := ClientConfig{
+		Host:     hostPort,
+		Username: username,
+		Password: password,
+	}
+
+	if config.TLS != nil {
+		cfg.TLS = &TLSConfig{
+			Mode:               config.TLS.Mode.ValueString(),
+			CACert:             config.TLS.CACert.ValueString(),
+			ClientCert:         config.TLS.ClientCert.ValueString(),
+			ClientKey:          config.TLS.ClientKey.ValueString(),
+			ServerName:         config.TLS.ServerName.ValueString(),
+			InsecureSkipVerify: config.TLS.InsecureSkipVerify.ValueBool(),
+		}
+		// Configuring a tls block without a mode opts into TLS.
+		if cfg.TLS.Mode == "" {
+			cfg.TLS.Mode = TLSModeRequired
+		}
+	}
+
+	for _, timeout := range []struct {
+		attr  string
+		value types.String
+		dest  *time.Duration
+	}{
+		{"connect_timeout", config.ConnectTimeout, &cfg.ConnectTimeout},
+		{"read_timeout", config.ReadTimeout, &cfg.ReadTimeout},
+		{"write_timeout", config.WriteTimeout, &cfg.WriteTimeout},
+	} {
+		if timeout.value.IsNull() {
+			continue
+		}
+		d, err := time.ParseDuration(timeout.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(timeout.attr), "Invalid Timeout", err.Error())
+			continue
+		}
+		*timeout.dest = d
+	}
+
+	if !config.DSNParams.IsNull() {
+		resp.Diagnostics.Append(config.DSNParams.ElementsAs(ctx, &cfg.Params, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := NewClient(cfg)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Create StarRocks Client", err.Error())
 		return
 	}
 
+	if err := c.Connect(ctx); err != nil {
+		if c.Version().Raw == "" {
+			c.Close()
+			resp.Diagnostics.AddError(
+				"Unable to Connect to StarRocks",
+				fmt.Sprintf("The provider could not connect to the StarRocks FE at %s: %s", hostPort, err),
+			)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Unrecognized StarRocks Version",
+			fmt.Sprintf("%s. Version-dependent checks will be skipped.", err),
+		)
+	} else if v := c.Version(); !v.AtLeast(minSupportedVersion.Major, minSupportedVersion.Minor, minSupportedVersion.Patch) {
+		resp.Diagnostics.AddWarning(
+			"Unsupported StarRocks Version",
+			fmt.Sprintf("The StarRocks FE reports version %s, but the provider requires %s or newer. Some resources may not work as expected.",
+				v.Raw, minSupportedVersion),
+		)
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
 }
 
+// stringFromConfigOrEnv returns the configured value, falling back to the
+// environment variable when the attribute is null.
+func stringFromConfigOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
 func (p *starrocksProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{}
 }
@@ -86,5 +307,20 @@ func (p *starrocksProvider) DataSources(_ context.Context) []func() datasource.D
 func (p *starrocksProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewResourceGroupResource,
+		NewDatabaseResource,
+		NewUserResource,
+		NewRoleResource,
+		NewGrantResource,
+		NewRoleGrantResource,
+		NewTableResource,
+		NewViewResource,
+		NewMaterializedViewResource,
+		NewExternalCatalogResource,
+		NewStorageVolumeResource,
+		NewWarehouseResource,
+		NewRoutineLoadResource,
+		NewPipeResource,
+		NewTaskResource,
+		NewGlobalVariableResource,
 	}
 }
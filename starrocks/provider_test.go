@@ -1,10 +1,15 @@
 package starrocks
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestProviderModel_PortHandling(t *testing.T) {
@@ -84,3 +89,73 @@ func TestProviderModel_HostPortCombination(t *testing.T) {
 		t.Errorf("hostPort = %v, want %v", hostPort, expected)
 	}
 }
+
+func TestStringFromConfigOrEnv(t *testing.T) {
+	t.Setenv(envHost, "env-host")
+
+	if got := stringFromConfigOrEnv(types.StringValue("config-host"), envHost); got != "config-host" {
+		t.Errorf("stringFromConfigOrEnv(config) = %q, want config-host", got)
+	}
+	if got := stringFromConfigOrEnv(types.StringNull(), envHost); got != "env-host" {
+		t.Errorf("stringFromConfigOrEnv(null) = %q, want env-host", got)
+	}
+}
+
+// providerConfig builds a provider configuration with every attribute null
+// except the given ones.
+func providerConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attrs),
+	}
+}
+
+func TestProviderConfigure_MissingSettings(t *testing.T) {
+	t.Setenv(envHost, "")
+	t.Setenv(envUser, "")
+
+	p := New("test")()
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{Config: providerConfig(t, nil)}, resp)
+
+	var summaries []string
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	want := []string{"Missing StarRocks Host", "Missing StarRocks Username"}
+	if strings.Join(summaries, ", ") != strings.Join(want, ", ") {
+		t.Errorf("Configure errors = %v, want %v", summaries, want)
+	}
+}
+
+func TestProviderConfigure_EnvFallback(t *testing.T) {
+	t.Setenv(envHost, "starrocks.local")
+	t.Setenv(envPort, "not-a-port")
+	t.Setenv(envUser, "admin")
+	t.Setenv(envPassword, "secret")
+
+	p := New("test")()
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{Config: providerConfig(t, nil)}, resp)
+
+	if len(resp.Diagnostics.Errors()) != 1 || resp.Diagnostics.Errors()[0].Summary() != "Invalid StarRocks Port" {
+		t.Errorf("Configure errors = %v, want only Invalid StarRocks Port", resp.Diagnostics.Errors())
+	}
+}
@@ -2,21 +2,37 @@ package starrocks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 var (
-	_ resource.Resource                = &resourceGroupResource{}
-	_ resource.ResourceWithConfigure   = &resourceGroupResource{}
-	_ resource.ResourceWithImportState = &resourceGroupResource{}
+	_ resource.Resource                     = &resourceGroupResource{}
+	_ resource.ResourceWithConfigure        = &resourceGroupResource{}
+	_ resource.ResourceWithImportState      = &resourceGroupResource{}
+	_ resource.ResourceWithUpgradeState     = &resourceGroupResource{}
+	_ resource.ResourceWithConfigValidators = &resourceGroupResource{}
+	_ resource.ResourceWithModifyPlan       = &resourceGroupResource{}
 )
 
+// classifierQueryTypes are the query types a classifier can match.
+var classifierQueryTypes = []string{"select", "insert", "load", "alter"}
+
 func NewResourceGroupResource() resource.Resource {
 	return &resourceGroupResource{}
 }
@@ -26,37 +42,49 @@ type resourceGroupResource struct {
 }
 
 type resourceGroupResourceModel struct {
-	Name                     types.String `tfsdk:"name"`
-	CPUWeight                types.Int64  `tfsdk:"cpu_weight"`
-	ExclusiveCPUCores        types.Int64  `tfsdk:"exclusive_cpu_cores"`
-	CPUCoreLimit             types.Int64  `tfsdk:"cpu_core_limit"`
-	MaxCPUCores              types.Int64  `tfsdk:"max_cpu_cores"`
-	MemLimit                 types.String `tfsdk:"mem_limit"`
-	ConcurrencyLimit         types.Int64  `tfsdk:"concurrency_limit"`
-	BigQueryMemLimit         types.Int64  `tfsdk:"big_query_mem_limit"`
-	BigQueryScanRowsLimit    types.Int64  `tfsdk:"big_query_scan_rows_limit"`
-	BigQueryCPUSecondLimit   types.Int64  `tfsdk:"big_query_cpu_second_limit"`
-	Classifiers              types.List   `tfsdk:"classifiers"`
-}
-
-func (m *resourceGroupResourceModel) GetName() types.String { return m.Name }
-func (m *resourceGroupResourceModel) GetCPUWeight() types.Int64 { return m.CPUWeight }
+	Name                   types.String    `tfsdk:"name"`
+	CPUWeight              types.Int64     `tfsdk:"cpu_weight"`
+	ExclusiveCPUCores      types.Int64     `tfsdk:"exclusive_cpu_cores"`
+	CPUCoreLimit           types.Int64     `tfsdk:"cpu_core_limit"`
+	MaxCPUCores            types.Int64     `tfsdk:"max_cpu_cores"`
+	MemLimit               PercentageValue `tfsdk:"mem_limit"`
+	ConcurrencyLimit       types.Int64     `tfsdk:"concurrency_limit"`
+	BigQueryMemLimit       types.Int64     `tfsdk:"big_query_mem_limit"`
+	BigQueryScanRowsLimit  types.Int64     `tfsdk:"big_query_scan_rows_limit"`
+	BigQueryCPUSecondLimit types.Int64     `tfsdk:"big_query_cpu_second_limit"`
+	SpillMemLimitThreshold PercentageValue `tfsdk:"spill_mem_limit_threshold"`
+	Warehouses             types.Set       `tfsdk:"warehouses"`
+	Classifiers            types.List      `tfsdk:"classifiers"`
+}
+
+func (m *resourceGroupResourceModel) GetName() types.String             { return m.Name }
+func (m *resourceGroupResourceModel) GetCPUWeight() types.Int64         { return m.CPUWeight }
 func (m *resourceGroupResourceModel) GetExclusiveCPUCores() types.Int64 { return m.ExclusiveCPUCores }
-func (m *resourceGroupResourceModel) GetCPUCoreLimit() types.Int64 { return m.CPUCoreLimit }
-func (m *resourceGroupResourceModel) GetMaxCPUCores() types.Int64 { return m.MaxCPUCores }
-func (m *resourceGroupResourceModel) GetMemLimit() types.String { return m.MemLimit }
-func (m *resourceGroupResourceModel) GetConcurrencyLimit() types.Int64 { return m.ConcurrencyLimit }
-func (m *resourceGroupResourceModel) GetBigQueryMemLimit() types.Int64 { return m.BigQueryMemLimit }
-func (m *resourceGroupResourceModel) GetBigQueryScanRowsLimit() types.Int64 { return m.BigQueryScanRowsLimit }
-func (m *resourceGroupResourceModel) GetBigQueryCPUSecondLimit() types.Int64 { return m.BigQueryCPUSecondLimit }
+func (m *resourceGroupResourceModel) GetCPUCoreLimit() types.Int64      { return m.CPUCoreLimit }
+func (m *resourceGroupResourceModel) GetMaxCPUCores() types.Int64       { return m.MaxCPUCores }
+func (m *resourceGroupResourceModel) GetMemLimit() PercentageValue      { return m.MemLimit }
+func (m *resourceGroupResourceModel) GetConcurrencyLimit() types.Int64  { return m.ConcurrencyLimit }
+func (m *resourceGroupResourceModel) GetBigQueryMemLimit() types.Int64  { return m.BigQueryMemLimit }
+func (m *resourceGroupResourceModel) GetBigQueryScanRowsLimit() types.Int64 {
+	return m.BigQueryScanRowsLimit
+}
+func (m *resourceGroupResourceModel) GetBigQueryCPUSecondLimit() types.Int64 {
+	return m.BigQueryCPUSecondLimit
+}
+func (m *resourceGroupResourceModel) GetSpillMemLimitThreshold() PercentageValue {
+	return m.SpillMemLimitThreshold
+}
+func (m *resourceGroupResourceModel) GetWarehouses() types.Set   { return m.Warehouses }
 func (m *resourceGroupResourceModel) GetClassifiers() types.List { return m.Classifiers }
 
 type classifierModel struct {
-	User   types.String  `tfsdk:"user"`
-	Role   types.String  `tfsdk:"role"`
-	QueryType types.String `tfsdk:"query_type"`
-	SourceIP  types.String `tfsdk:"source_ip"`
-	DB        types.String `tfsdk:"db"`
+	User             types.String `tfsdk:"user"`
+	Role             types.String `tfsdk:"role"`
+	QueryType        types.Set    `tfsdk:"query_type"`
+	SourceIP         types.String `tfsdk:"source_ip"`
+	DB               types.String `tfsdk:"db"`
+	PlanCPUCostRange types.String `tfsdk:"plan_cpu_cost_range"`
+	PlanMemCostRange types.String `tfsdk:"plan_mem_cost_range"`
 }
 
 func (r *resourceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,26 +93,139 @@ func (r *resourceGroupResource) Metadata(_ context.Context, req resource.Metadat
 
 func (r *resourceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
-			"name":                          schema.StringAttribute{Required: true},
-			"cpu_weight":                    schema.Int64Attribute{Optional: true},
-			"exclusive_cpu_cores":           schema.Int64Attribute{Optional: true},
-			"cpu_core_limit":                schema.Int64Attribute{Optional: true},
-			"max_cpu_cores":                 schema.Int64Attribute{Optional: true},
-			"mem_limit":                     schema.StringAttribute{Optional: true},
-			"concurrency_limit":             schema.Int64Attribute{Optional: true},
-			"big_query_mem_limit":           schema.Int64Attribute{Optional: true},
-			"big_query_scan_rows_limit":     schema.Int64Attribute{Optional: true},
-			"big_query_cpu_second_limit":    schema.Int64Attribute{Optional: true},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cpu_weight": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"exclusive_cpu_cores": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cpu_core_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_cpu_cores": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"mem_limit": schema.StringAttribute{
+				CustomType: PercentageType{},
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					percentageValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"concurrency_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"big_query_mem_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"big_query_scan_rows_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"big_query_cpu_second_limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"spill_mem_limit_threshold": schema.StringAttribute{
+				CustomType: PercentageType{},
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					percentageValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouses": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 			"classifiers": schema.ListNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"user":       schema.StringAttribute{Optional: true},
-						"role":       schema.StringAttribute{Optional: true},
-						"query_type": schema.StringAttribute{Optional: true},
-						"source_ip":  schema.StringAttribute{Optional: true},
-						"db":         schema.StringAttribute{Optional: true},
+						"user": schema.StringAttribute{Optional: true},
+						"role": schema.StringAttribute{Optional: true},
+						"query_type": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOfCaseInsensitive(classifierQueryTypes...)),
+							},
+						},
+						"source_ip": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								cidrValidator{},
+							},
+						},
+						"db": schema.StringAttribute{Optional: true},
+						"plan_cpu_cost_range": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								costRangeValidator{},
+							},
+						},
+						"plan_mem_cost_range": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								costRangeValidator{},
+							},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.AtLeastOneOf(
+							path.MatchRelative().AtName("user"),
+							path.MatchRelative().AtName("role"),
+							path.MatchRelative().AtName("query_type"),
+							path.MatchRelative().AtName("source_ip"),
+							path.MatchRelative().AtName("db"),
+							path.MatchRelative().AtName("plan_cpu_cost_range"),
+							path.MatchRelative().AtName("plan_mem_cost_range"),
+						),
 					},
 				},
 			},
@@ -92,6 +233,39 @@ func (r *resourceGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *resourceGroupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	// cpu_core_limit is the pre-3.3 name of cpu_weight, and a group either
+	// shares CPU by weight or owns exclusive cores, so at most one of the
+	// three may be set.
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cpu_weight"),
+			path.MatchRoot("cpu_core_limit"),
+			path.MatchRoot("exclusive_cpu_cores"),
+		),
+	}
+}
+
+func (r *resourceGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ExclusiveCPUCores.IsNull() && !r.client.SupportsVersion(3, 3, 5) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("exclusive_cpu_cores"),
+			"Unsupported Resource Group Property",
+			fmt.Sprintf("exclusive_cpu_cores requires StarRocks 3.3.5 or newer, but the server reports %s.", r.client.Version().Raw),
+		)
+	}
+}
+
 func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resourceGroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -104,6 +278,21 @@ func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Fill in the server defaults of computed properties
+	if plan.MemLimit.IsUnknown() || plan.SpillMemLimitThreshold.IsUnknown() {
+		rg, err := r.client.GetResourceGroup(plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading resource group", err.Error())
+			return
+		}
+		if plan.MemLimit.IsUnknown() {
+			plan.MemLimit = rg.MemLimit
+		}
+		if plan.SpillMemLimitThreshold.IsUnknown() {
+			plan.SpillMemLimitThreshold = rg.SpillMemLimitThreshold
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -115,59 +304,83 @@ func (r *resourceGroupResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	rg, err := r.client.GetResourceGroup(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading resource group", err.Error())
 		return
 	}
 
-	// Update only the fields that GetResourceGroup returns, keep classifiers from state
 	state.Name = rg.Name
-	if !rg.CPUWeight.IsNull() {
-		state.CPUWeight = rg.CPUWeight
-	}
-	if !rg.ExclusiveCPUCores.IsNull() {
-		state.ExclusiveCPUCores = rg.ExclusiveCPUCores
+	// cpu_core_limit is the name cpu_weight had before StarRocks 3.3, and a
+	// server only reports one of the two columns. Keep whichever name the
+	// configuration uses.
+	cpuWeight := rg.CPUWeight
+	if cpuWeight.IsNull() {
+		cpuWeight = rg.CPUCoreLimit
 	}
-	if !rg.CPUCoreLimit.IsNull() {
-		state.CPUCoreLimit = rg.CPUCoreLimit
+	if !state.CPUCoreLimit.IsNull() && state.CPUWeight.IsNull() {
+		state.CPUCoreLimit = cpuWeight
+	} else {
+		state.CPUWeight = cpuWeight
+		state.CPUCoreLimit = types.Int64Null()
 	}
+	state.ExclusiveCPUCores = rg.ExclusiveCPUCores
+	// max_cpu_cores is no longer reported by recent versions
 	if !rg.MaxCPUCores.IsNull() {
 		state.MaxCPUCores = rg.MaxCPUCores
 	}
-	// Keep mem_limit from state to avoid drift from "80%" vs "80.0%"
-	if !rg.ConcurrencyLimit.IsNull() {
-		state.ConcurrencyLimit = rg.ConcurrencyLimit
-	}
-	if !rg.BigQueryMemLimit.IsNull() {
-		state.BigQueryMemLimit = rg.BigQueryMemLimit
-	}
-	if !rg.BigQueryScanRowsLimit.IsNull() {
-		state.BigQueryScanRowsLimit = rg.BigQueryScanRowsLimit
-	}
-	if !rg.BigQueryCPUSecondLimit.IsNull() {
-		state.BigQueryCPUSecondLimit = rg.BigQueryCPUSecondLimit
-	}
-	// Keep classifiers from existing state since GetResourceGroup doesn't return them properly
+	// PercentageValue keeps the configured spelling when the server reports
+	// an equivalent value, e.g. "80.0%" for "80%".
+	state.MemLimit = rg.MemLimit
+	state.ConcurrencyLimit = rg.ConcurrencyLimit
+	state.BigQueryMemLimit = rg.BigQueryMemLimit
+	state.BigQueryScanRowsLimit = rg.BigQueryScanRowsLimit
+	state.BigQueryCPUSecondLimit = rg.BigQueryCPUSecondLimit
+	state.SpillMemLimitThreshold = rg.SpillMemLimitThreshold
+	state.Warehouses = rg.Warehouses
+	state.Classifiers = classifiersToList(mergeClassifiers(classifiersFromList(state.Classifiers), rg.classifiers))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *resourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resourceGroupResourceModel
+	var plan, state resourceGroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Delete and recreate
-	if err := r.client.DeleteResourceGroup(plan.Name.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Unable to Delete Resource Group", err.Error())
-		return
+	name := plan.Name.ValueString()
+
+	if props := changedResourceGroupProperties(&state, &plan); len(props) > 0 {
+		if err := r.client.AlterResourceGroupProperties(name, props); err != nil {
+			resp.Diagnostics.AddError("Unable to Update Resource Group", err.Error())
+			return
+		}
 	}
 
-	if err := r.client.CreateResourceGroup(&plan); err != nil {
-		resp.Diagnostics.AddError("Unable to Create Resource Group", err.Error())
+	// Add new classifiers before dropping old ones so that matching queries
+	// never fall back to the default group in between.
+	added, removed := diffClassifiers(classifiersFromList(state.Classifiers), classifiersFromList(plan.Classifiers))
+	if err := r.client.AddResourceGroupClassifiers(name, added); err != nil {
+		resp.Diagnostics.AddError("Unable to Add Resource Group Classifiers", err.Error())
 		return
 	}
+	if len(removed) > 0 {
+		rg, err := r.client.GetResourceGroup(name)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading resource group", err.Error())
+			return
+		}
+		if err := r.client.DropResourceGroupClassifiers(name, matchClassifierIDs(rg.classifiers, removed)); err != nil {
+			resp.Diagnostics.AddError("Unable to Drop Resource Group Classifiers", err.Error())
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -179,7 +392,7 @@ func (r *resourceGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if err := r.client.DeleteResourceGroup(state.Name.ValueString()); err != nil {
+	if err := r.client.DeleteResourceGroup(state.Name.ValueString()); err != nil && !IsNotFound(err) {
 		resp.Diagnostics.AddError("Unable to Delete Resource Group", err.Error())
 	}
 }
@@ -210,13 +423,9 @@ func (r *resourceGroupResource) ImportState(ctx context.Context, req resource.Im
 		BigQueryMemLimit:       rg.BigQueryMemLimit,
 		BigQueryScanRowsLimit:  rg.BigQueryScanRowsLimit,
 		BigQueryCPUSecondLimit: rg.BigQueryCPUSecondLimit,
-		Classifiers:            types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
-			"user":       types.StringType,
-			"role":       types.StringType,
-			"query_type": types.StringType,
-			"source_ip":  types.StringType,
-			"db":         types.StringType,
-		}}),
+		SpillMemLimitThreshold: rg.SpillMemLimitThreshold,
+		Warehouses:             rg.Warehouses,
+		Classifiers:            rg.Classifiers,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -235,3 +444,46 @@ func (r *resourceGroupResource) Configure(_ context.Context, req resource.Config
 
 	r.client = c
 }
+
+func (r *resourceGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored classifiers[*].query_type as a single string.
+		0: {
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgraded, err := upgradeResourceGroupStateV0(req.RawState.JSON)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource Group State", err.Error())
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeResourceGroupStateV0 converts the raw JSON state of schema version 0
+// by wrapping each classifier query_type into a set and adding the cost
+// range attributes.
+func upgradeResourceGroupStateV0(raw []byte) ([]byte, error) {
+	var state map[string]interface{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+
+	classifiers, _ := state["classifiers"].([]interface{})
+	for _, elem := range classifiers {
+		classifier, ok := elem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if qt, ok := classifier["query_type"].(string); ok {
+			classifier["query_type"] = []interface{}{strings.ToLower(qt)}
+		} else {
+			classifier["query_type"] = nil
+		}
+		classifier["plan_cpu_cost_range"] = nil
+		classifier["plan_mem_cost_range"] = nil
+	}
+
+	return json.Marshal(state)
+}
@@ -10,7 +10,7 @@ func TestResourceGroupModel_Getters(t *testing.T) {
 	model := &resourceGroupResourceModel{
 		Name:                   types.StringValue("test_rg"),
 		CPUWeight:              types.Int64Value(10),
-		MemLimit:               types.StringValue("80.0%"),
+		MemLimit:               NewPercentageValue("80.0%"),
 		ConcurrencyLimit:       types.Int64Value(5),
 		BigQueryMemLimit:       types.Int64Value(1073741824),
 		BigQueryScanRowsLimit:  types.Int64Value(100000),
@@ -30,3 +30,17 @@ func TestResourceGroupModel_Getters(t *testing.T) {
 		t.Errorf("GetConcurrencyLimit() = %v, want 5", model.GetConcurrencyLimit().ValueInt64())
 	}
 }
+
+func TestUpgradeResourceGroupStateV0(t *testing.T) {
+	raw := []byte(`{"name":"rg","classifiers":[{"user":"u1","role":null,"query_type":"SELECT","source_ip":null,"db":null},{"user":"u2","role":null,"query_type":null,"source_ip":null,"db":null}]}`)
+
+	upgraded, err := upgradeResourceGroupStateV0(raw)
+	if err != nil {
+		t.Fatalf("upgradeResourceGroupStateV0 failed: %v", err)
+	}
+
+	want := `{"classifiers":[{"db":null,"plan_cpu_cost_range":null,"plan_mem_cost_range":null,"query_type":["select"],"role":null,"source_ip":null,"user":"u1"},{"db":null,"plan_cpu_cost_range":null,"plan_mem_cost_range":null,"query_type":null,"role":null,"source_ip":null,"user":"u2"}],"name":"rg"}`
+	if string(upgraded) != want {
+		t.Errorf("upgradeResourceGroupStateV0() = %s, want %s", upgraded, want)
+	}
+}
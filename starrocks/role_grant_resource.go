@@ -0,0 +1,303 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &roleGrantResource{}
+	_ resource.ResourceWithConfigure      = &roleGrantResource{}
+	_ resource.ResourceWithImportState    = &roleGrantResource{}
+	_ resource.ResourceWithValidateConfig = &roleGrantResource{}
+)
+
+func NewRoleGrantResource() resource.Resource {
+	return &roleGrantResource{}
+}
+
+type roleGrantResource struct {
+	client *Client
+}
+
+type roleGrantResourceModel struct {
+	User         types.String `tfsdk:"user"`
+	Roles        types.Set    `tfsdk:"roles"`
+	DefaultRoles types.Set    `tfsdk:"default_roles"`
+}
+
+// requiresReplaceUserIdentity forces a new resource when the user identity
+// changes, but not when it is only spelled differently, e.g. jane@% instead
+// of the 'jane'@'%' recorded by an import.
+func requiresReplaceUserIdentity(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !sameUserIdentity(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+func (r *roleGrantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_grant"
+}
+
+func (r *roleGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the roles granted to a StarRocks user.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUserIdentity,
+						"Changing the user forces a new role grant.",
+						"Changing the user forces a new role grant.",
+					),
+				},
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"default_roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config roleGrantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.User.IsUnknown() {
+		if _, _, err := parseUserIdentity(config.User.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("user"), "Invalid User Identity", err.Error())
+		}
+	}
+
+	if config.Roles.IsUnknown() || config.DefaultRoles.IsUnknown() {
+		return
+	}
+	granted := make(map[string]bool)
+	for _, role := range stringSetValues(config.Roles) {
+		granted[role] = true
+	}
+	for _, role := range stringSetValues(config.DefaultRoles) {
+		if !granted[role] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_roles"),
+				"Invalid Default Role",
+				fmt.Sprintf("Default role %q must also be listed in roles.", role),
+			)
+		}
+	}
+}
+
+func (r *roleGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, host, err := parseUserIdentity(plan.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	if err := r.client.GrantRolesToUser(name, host, stringSetValues(plan.Roles)); err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Roles", err.Error())
+		return
+	}
+
+	if !plan.DefaultRoles.IsNull() {
+		if err := r.client.SetDefaultRoles(name, host, stringSetValues(plan.DefaultRoles)); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Default Roles", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, host, err := parseUserIdentity(state.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	roles, err := r.client.GetUserRoles(name, host)
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role grant", err.Error())
+		return
+	}
+
+	// Roles granted to the user outside this resource are left alone; only
+	// managed roles that were revoked are reported.
+	current := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		current[role] = true
+	}
+	var managed []string
+	for _, role := range stringSetValues(state.Roles) {
+		if current[role] {
+			managed = append(managed, role)
+		}
+	}
+	if len(managed) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Roles = stringSet(managed)
+
+	if !state.DefaultRoles.IsNull() {
+		defaults, err := r.client.GetUserDefaultRoles(name, host)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading default roles", err.Error())
+			return
+		}
+		state.DefaultRoles = stringSet(defaults)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, host, err := parseUserIdentity(plan.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	added, removed := diffStrings(stringSetValues(state.Roles), stringSetValues(plan.Roles))
+	if err := r.client.GrantRolesToUser(name, host, added); err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Roles", err.Error())
+		return
+	}
+
+	// Default roles are updated before revoking, so that a default role is
+	// never one the user no longer holds.
+	if !plan.DefaultRoles.Equal(state.DefaultRoles) {
+		if err := r.client.SetDefaultRoles(name, host, stringSetValues(plan.DefaultRoles)); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Default Roles", err.Error())
+			return
+		}
+	}
+
+	if err := r.client.RevokeRolesFromUser(name, host, removed); err != nil {
+		resp.Diagnostics.AddError("Unable to Revoke Roles", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, host, err := parseUserIdentity(state.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User Identity", err.Error())
+		return
+	}
+
+	if !state.DefaultRoles.IsNull() {
+		if err := r.client.SetDefaultRoles(name, host, nil); err != nil {
+			if IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError("Unable to Reset Default Roles", err.Error())
+			return
+		}
+	}
+
+	if err := r.client.RevokeRolesFromUser(name, host, stringSetValues(state.Roles)); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Revoke Roles", err.Error())
+	}
+}
+
+func (r *roleGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, host, err := parseUserIdentity(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a user identity such as 'name'@'host': %v", err),
+		)
+		return
+	}
+
+	roles, err := r.client.GetUserRoles(name, host)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing role grant", err.Error())
+		return
+	}
+	if len(roles) == 0 {
+		resp.Diagnostics.AddError("Error importing role grant", fmt.Sprintf("no roles are granted to %s", userIdentity(name, host)))
+		return
+	}
+
+	state := roleGrantResourceModel{
+		User:         types.StringValue(userIdentity(name, host)),
+		Roles:        stringSet(roles),
+		DefaultRoles: types.SetNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleGrantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
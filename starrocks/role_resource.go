@@ -0,0 +1,184 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &roleResource{}
+	_ resource.ResourceWithConfigure   = &roleResource{}
+	_ resource.ResourceWithImportState = &roleResource{}
+)
+
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+type roleResource struct {
+	client *Client
+}
+
+type roleResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Comment     types.String `tfsdk:"comment"`
+	ParentRoles types.Set    `tfsdk:"parent_roles"`
+}
+
+func (m *roleResourceModel) GetName() types.String    { return m.Name }
+func (m *roleResourceModel) GetComment() types.String { return m.Comment }
+
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks role.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"parent_roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateRole(&plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Role", err.Error())
+		return
+	}
+
+	if err := r.client.GrantRolesToRole(plan.Name.ValueString(), stringSetValues(plan.ParentRoles)); err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Parent Roles", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetRole(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading role", err.Error())
+		return
+	}
+
+	state.Comment = role.Comment
+	state.ParentRoles = role.ParentRoles
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	if !plan.Comment.Equal(state.Comment) {
+		if err := r.client.SetRoleComment(name, plan.Comment); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Role Comment", err.Error())
+			return
+		}
+	}
+
+	added, removed := diffStrings(stringSetValues(state.ParentRoles), stringSetValues(plan.ParentRoles))
+	if err := r.client.GrantRolesToRole(name, added); err != nil {
+		resp.Diagnostics.AddError("Unable to Grant Parent Roles", err.Error())
+		return
+	}
+	if err := r.client.RevokeRolesFromRole(name, removed); err != nil {
+		resp.Diagnostics.AddError("Unable to Revoke Parent Roles", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropRole(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Role", err.Error())
+	}
+}
+
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	role, err := r.client.GetRole(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing role", err.Error())
+		return
+	}
+
+	state := roleResourceModel{
+		Name:        role.Name,
+		Comment:     role.Comment,
+		ParentRoles: role.ParentRoles,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,479 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &routineLoadResource{}
+	_ resource.ResourceWithConfigure      = &routineLoadResource{}
+	_ resource.ResourceWithImportState    = &routineLoadResource{}
+	_ resource.ResourceWithValidateConfig = &routineLoadResource{}
+)
+
+func NewRoutineLoadResource() resource.Resource {
+	return &routineLoadResource{}
+}
+
+type routineLoadResource struct {
+	client *Client
+}
+
+type routineLoadResourceModel struct {
+	Database                types.String `tfsdk:"database"`
+	Name                    types.String `tfsdk:"name"`
+	Table                   types.String `tfsdk:"table"`
+	Columns                 types.List   `tfsdk:"columns"`
+	Where                   types.String `tfsdk:"where"`
+	DesiredConcurrentNumber types.Int64  `tfsdk:"desired_concurrent_number"`
+	MaxBatchInterval        types.Int64  `tfsdk:"max_batch_interval"`
+	Format                  types.String `tfsdk:"format"`
+	JSONPaths               types.String `tfsdk:"jsonpaths"`
+	Properties              types.Map    `tfsdk:"properties"`
+	Kafka                   types.Object `tfsdk:"kafka"`
+	State                   types.String `tfsdk:"state"`
+}
+
+func (m *routineLoadResourceModel) routineLoad() *RoutineLoad {
+	return &RoutineLoad{
+		Database:                m.Database,
+		Name:                    m.Name,
+		Table:                   m.Table,
+		Columns:                 m.Columns,
+		Where:                   m.Where,
+		DesiredConcurrentNumber: m.DesiredConcurrentNumber,
+		MaxBatchInterval:        m.MaxBatchInterval,
+		Format:                  m.Format,
+		JSONPaths:               m.JSONPaths,
+		Properties:              m.Properties,
+		Kafka:                   kafkaFromObject(m.Kafka),
+		State:                   m.State,
+	}
+}
+
+// applyComputed fills in computed attributes that were unknown in the plan
+// from the job reported by the server.
+func (m *routineLoadResourceModel) applyComputed(rl *RoutineLoad) {
+	if m.DesiredConcurrentNumber.IsUnknown() {
+		m.DesiredConcurrentNumber = rl.DesiredConcurrentNumber
+	}
+	if m.MaxBatchInterval.IsUnknown() {
+		m.MaxBatchInterval = rl.MaxBatchInterval
+	}
+	if m.Format.IsUnknown() {
+		m.Format = rl.Format
+	}
+}
+
+// refresh updates the model from the server. Attributes that SHOW ROUTINE
+// LOAD does not report keep their values.
+func (m *routineLoadResourceModel) refresh(rl *RoutineLoad) {
+	m.Table = rl.Table
+	m.State = rl.State
+	if !rl.DesiredConcurrentNumber.IsNull() {
+		m.DesiredConcurrentNumber = rl.DesiredConcurrentNumber
+	}
+	if !rl.MaxBatchInterval.IsNull() {
+		m.MaxBatchInterval = rl.MaxBatchInterval
+	}
+	if !rl.Format.IsNull() {
+		m.Format = rl.Format
+	}
+	if !m.JSONPaths.IsNull() && !rl.JSONPaths.IsNull() {
+		m.JSONPaths = rl.JSONPaths
+	}
+
+	kafka := kafkaFromObject(m.Kafka)
+	if kafka == nil {
+		kafka = rl.Kafka
+	} else if rl.Kafka != nil {
+		if !rl.Kafka.BrokerList.IsNull() {
+			kafka.BrokerList = rl.Kafka.BrokerList
+		}
+		if !rl.Kafka.Topic.IsNull() {
+			kafka.Topic = rl.Kafka.Topic
+		}
+	}
+	m.Kafka = kafkaToObject(kafka)
+}
+
+func (r *routineLoadResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routine_load"
+}
+
+func (r *routineLoadResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks routine load job that ingests data from Kafka.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"where": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"desired_concurrent_number": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_batch_interval": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("csv", "json", "avro"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jsonpaths": schema.StringAttribute{
+				Optional: true,
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+			"kafka": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"broker_list": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"topic": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"partitions": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"offsets": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+					"properties": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						PlanModifiers: []planmodifier.Map{
+							untrackedPropertiesWarning{},
+						},
+					},
+					"credentials": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(routineLoadRunning),
+				Validators: []validator.String{
+					stringvalidator.OneOf(routineLoadRunning, routineLoadPaused),
+				},
+			},
+		},
+	}
+}
+
+func (r *routineLoadResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config routineLoadResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for k := range mapValues(config.Properties) {
+		switch k {
+		case routineLoadDesiredConcurrentNumber, routineLoadMaxBatchInterval, routineLoadFormat, routineLoadJSONPaths:
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Property", fmt.Sprintf("Use the %s attribute instead of the %q property.", k, k))
+		}
+	}
+
+	if f := config.Format; !config.JSONPaths.IsNull() && !f.IsUnknown() && f.ValueString() != "json" {
+		resp.Diagnostics.AddAttributeError(path.Root("jsonpaths"), "Invalid Attribute Combination", `jsonpaths requires format = "json".`)
+	}
+
+	kafka := kafkaFromObject(config.Kafka)
+	if kafka == nil {
+		return
+	}
+	if !kafka.Offsets.IsNull() && !kafka.Offsets.IsUnknown() && !kafka.Partitions.IsUnknown() &&
+		len(kafka.Offsets.Elements()) != len(kafka.Partitions.Elements()) {
+		resp.Diagnostics.AddAttributeError(path.Root("kafka").AtName("offsets"), "Invalid Attribute Combination", "kafka.offsets must have one entry for each of kafka.partitions.")
+	}
+	credentials := mapValues(kafka.Credentials)
+	for k := range mapValues(kafka.Properties) {
+		if _, ok := credentials[k]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("kafka").AtName("properties"), "Conflicting Property", fmt.Sprintf("Property %q is also set in kafka.credentials.", k))
+		}
+	}
+	for _, m := range []types.Map{kafka.Properties, kafka.Credentials} {
+		for k := range mapValues(m) {
+			if strings.HasPrefix(k, kafkaPropertyPrefix) {
+				resp.Diagnostics.AddAttributeError(path.Root("kafka"), "Invalid Property", fmt.Sprintf("Property %q must be set without the %q prefix.", k, kafkaPropertyPrefix))
+			}
+		}
+	}
+}
+
+func (r *routineLoadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan routineLoadResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, name := plan.Database.ValueString(), plan.Name.ValueString()
+	if err := r.client.CreateRoutineLoad(plan.routineLoad()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Routine Load", err.Error())
+		return
+	}
+
+	if plan.State.ValueString() == routineLoadPaused {
+		if err := r.client.PauseRoutineLoad(database, name); err != nil {
+			resp.Diagnostics.AddError("Unable to Pause Routine Load", err.Error())
+			return
+		}
+	}
+
+	rl, err := r.client.GetRoutineLoad(database, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading routine load", err.Error())
+		return
+	}
+	plan.applyComputed(rl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *routineLoadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state routineLoadResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rl, err := r.client.GetRoutineLoad(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading routine load", err.Error())
+		return
+	}
+
+	state.refresh(rl)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *routineLoadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state routineLoadResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, name := plan.Database.ValueString(), plan.Name.ValueString()
+	planned, current := plan.routineLoad(), state.routineLoad()
+
+	var clauses []string
+	if !plan.Columns.Equal(state.Columns) || !plan.Where.Equal(state.Where) {
+		clauses = routineLoadLoadClauses(plan.Columns, plan.Where)
+	}
+
+	// Partitions and offsets are always sent together.
+	jobProps := changedProperties(routineLoadJobProperties(current), routineLoadJobProperties(planned))
+	plannedKafka := kafkaSourceProperties(planned.Kafka, false)
+	kafkaProps := changedProperties(kafkaSourceProperties(current.Kafka, false), plannedKafka)
+	_, partitionsChanged := kafkaProps["kafka_partitions"]
+	_, offsetsChanged := kafkaProps["kafka_offsets"]
+	if partitionsChanged || offsetsChanged {
+		for _, k := range []string{"kafka_partitions", "kafka_offsets"} {
+			if v, ok := plannedKafka[k]; ok {
+				kafkaProps[k] = v
+			}
+		}
+	}
+
+	// Routine load jobs can only be altered while paused.
+	paused := state.State.ValueString() == routineLoadPaused
+	if len(clauses) > 0 || len(jobProps) > 0 || len(kafkaProps) > 0 {
+		if !paused {
+			if err := r.client.PauseRoutineLoad(database, name); err != nil {
+				resp.Diagnostics.AddError("Unable to Pause Routine Load", err.Error())
+				return
+			}
+			paused = true
+		}
+		if err := r.client.AlterRoutineLoad(database, name, clauses, jobProps, kafkaProps); err != nil {
+			resp.Diagnostics.AddError("Unable to Alter Routine Load", err.Error())
+			return
+		}
+	}
+
+	switch wantPaused := plan.State.ValueString() == routineLoadPaused; {
+	case paused && !wantPaused:
+		if err := r.client.ResumeRoutineLoad(database, name); err != nil {
+			resp.Diagnostics.AddError("Unable to Resume Routine Load", err.Error())
+			return
+		}
+	case !paused && wantPaused:
+		if err := r.client.PauseRoutineLoad(database, name); err != nil {
+			resp.Diagnostics.AddError("Unable to Pause Routine Load", err.Error())
+			return
+		}
+	}
+
+	rl, err := r.client.GetRoutineLoad(database, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading routine load", err.Error())
+		return
+	}
+	plan.applyComputed(rl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *routineLoadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state routineLoadResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.StopRoutineLoad(state.Database.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Stop Routine Load", err.Error())
+	}
+}
+
+func (r *routineLoadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<name>, got: %q", req.ID))
+		return
+	}
+
+	rl, err := r.client.GetRoutineLoad(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing routine load", err.Error())
+		return
+	}
+
+	state := routineLoadResourceModel{
+		Database:   rl.Database,
+		Name:       rl.Name,
+		Columns:    rl.Columns,
+		Where:      rl.Where,
+		JSONPaths:  types.StringNull(),
+		Properties: types.MapNull(types.StringType),
+		Kafka:      types.ObjectNull(routineLoadKafkaAttrTypes),
+	}
+	state.refresh(rl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *routineLoadResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,218 @@
+package starrocks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// quoteIdentifier quotes a database object name with backticks so that it
+// can be safely interpolated into a statement. Embedded backticks are
+// doubled, as MySQL and StarRocks expect.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// quoteQualifiedIdentifier quotes each part of a dotted object name, e.g.
+// catalog.db.table, skipping empty parts.
+func quoteQualifiedIdentifier(parts ...string) string {
+	quoted := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			quoted = append(quoted, quoteIdentifier(part))
+		}
+	}
+	return strings.Join(quoted, ".")
+}
+
+// quoteIdentifiers quotes each name and joins them with commas.
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// unquoteName strips one level of single, double or backtick quotes from an
+// object name as printed in SHOW output.
+func unquoteName(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != s[len(s)-1] || !strings.ContainsRune("'\"`", rune(s[0])) {
+		return s
+	}
+	quote := s[:1]
+	return strings.ReplaceAll(s[1:len(s)-1], quote+quote, quote)
+}
+
+// splitNameList splits a comma-separated list of possibly quoted names,
+// ignoring commas inside quotes. Elements are trimmed but left quoted.
+func splitNameList(s string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ',':
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if rest := strings.TrimSpace(s[start:]); rest != "" || len(parts) > 0 {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+// stringLiteralEscaper escapes the characters that terminate or alter a
+// single-quoted string literal.
+var stringLiteralEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\x00", `\0`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\x1a", `\Z`,
+)
+
+// quoteString renders s as a single-quoted string literal.
+func quoteString(s string) string {
+	return "'" + stringLiteralEscaper.Replace(s) + "'"
+}
+
+// quoteStrings renders each value as a string literal and joins them with
+// commas, e.g. for IN lists.
+func quoteStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteString(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// quoteProperty renders a "'key' = 'value'" pair as used in WITH and
+// PROPERTIES clauses.
+func quoteProperty(key, value string) string {
+	return fmt.Sprintf("%s = %s", quoteString(key), quoteString(value))
+}
+
+// quotePropertiesClause renders a PROPERTIES clause with the given keys in
+// sorted order, or an empty string when there are no properties.
+func quotePropertiesClause(props map[string]string) string {
+	if len(props) == 0 {
+		return ""
+	}
+	return " PROPERTIES (" + quotePropertyList(props) + ")"
+}
+
+// quotePropertyList renders "'key' = 'value'" pairs joined with commas, in
+// sorted key order.
+func quotePropertyList(props map[string]string) string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = quoteProperty(k, props[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// changedProperties returns the entries of after that are new or differ from
+// before. StarRocks cannot reset a property to its default, so properties
+// missing from after are left as they are on the server.
+func changedProperties(before, after map[string]string) map[string]string {
+	changed := make(map[string]string)
+	for k, v := range after {
+		if old, ok := before[k]; !ok || old != v {
+			changed[k] = v
+		}
+	}
+	return changed
+}
+
+// parseProperties extracts the key/value pairs of the PROPERTIES clause in a
+// SHOW CREATE statement, e.g. PROPERTIES ("replication_num" = "3").
+func parseProperties(ddl string) map[string]string {
+	props := make(map[string]string)
+
+	idx := strings.Index(strings.ToUpper(ddl), "PROPERTIES")
+	if idx < 0 {
+		return props
+	}
+	rest := ddl[idx+len("PROPERTIES"):]
+	paren := strings.Index(rest, "(")
+	if paren < 0 {
+		return props
+	}
+	rest = rest[paren+1:]
+
+	var literals []string
+	for {
+		lit, tail, ok := nextStringLiteral(rest)
+		if !ok {
+			break
+		}
+		literals = append(literals, lit)
+		rest = strings.TrimSpace(tail)
+		if strings.HasPrefix(rest, ")") {
+			break
+		}
+	}
+
+	for i := 0; i+1 < len(literals); i += 2 {
+		props[literals[i]] = literals[i+1]
+	}
+	return props
+}
+
+// nextStringLiteral finds the next single- or double-quoted literal in s and
+// returns its unescaped content and the text following it.
+func nextStringLiteral(s string) (string, string, bool) {
+	start := strings.IndexAny(s, `"'`)
+	if start < 0 {
+		return "", "", false
+	}
+	// Stop at the end of the clause.
+	if end := strings.Index(s, ")"); end >= 0 && end < start {
+		return "", "", false
+	}
+
+	quote := s[start]
+	var b strings.Builder
+	for i := start + 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(unescapeByte(s[i]))
+		case c == quote:
+			return b.String(), s[i+1:], true
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", false
+}
+
+func unescapeByte(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case '0':
+		return 0
+	}
+	return c
+}
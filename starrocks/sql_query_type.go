@@ -0,0 +1,150 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SQLQueryType{}
+	_ basetypes.StringValuableWithSemanticEquals = SQLQueryValue{}
+)
+
+// SQLQueryType is a string type for SQL statements such as view queries.
+// StarRocks does not keep the original formatting of a stored query, so
+// values are compared ignoring whitespace.
+type SQLQueryType struct {
+	basetypes.StringType
+}
+
+func (t SQLQueryType) String() string {
+	return "SQLQueryType"
+}
+
+func (t SQLQueryType) Equal(o attr.Type) bool {
+	other, ok := o.(SQLQueryType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SQLQueryType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SQLQueryValue{StringValue: in}, nil
+}
+
+func (t SQLQueryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t SQLQueryType) ValueType(_ context.Context) attr.Value {
+	return SQLQueryValue{}
+}
+
+// SQLQueryValue is the value of a SQLQueryType attribute.
+type SQLQueryValue struct {
+	basetypes.StringValue
+}
+
+func NewSQLQueryValue(value string) SQLQueryValue {
+	return SQLQueryValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewSQLQueryNull() SQLQueryValue {
+	return SQLQueryValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewSQLQueryUnknown() SQLQueryValue {
+	return SQLQueryValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v SQLQueryValue) Type(_ context.Context) attr.Type {
+	return SQLQueryType{}
+}
+
+func (v SQLQueryValue) Equal(o attr.Value) bool {
+	other, ok := o.(SQLQueryValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values are the same query up
+// to whitespace, e.g. "SELECT a\nFROM t;" and "SELECT a FROM t".
+func (v SQLQueryValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SQLQueryValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeQuery(v.ValueString()) == normalizeQuery(newValue.ValueString()), diags
+}
+
+// normalizeQuery collapses whitespace outside quotes to single spaces,
+// drops it next to punctuation and removes a trailing semicolon.
+func normalizeQuery(s string) string {
+	var b strings.Builder
+	var quote, last byte
+	space := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			b.WriteByte(c)
+			switch {
+			case c == '\\' && quote != '`' && i+1 < len(s):
+				i++
+				b.WriteByte(s[i])
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		if strings.IndexByte(" \t\r\n", c) >= 0 {
+			space = true
+			continue
+		}
+		if space && last != 0 && !isQueryPunctuation(c) && !isQueryPunctuation(last) {
+			b.WriteByte(' ')
+		}
+		space = false
+		last = c
+		if c == '\'' || c == '"' || c == '`' {
+			quote = c
+		}
+		b.WriteByte(c)
+	}
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// isQueryPunctuation reports whether whitespace around c is insignificant.
+func isQueryPunctuation(c byte) bool {
+	return strings.IndexByte("(),;=<>+-*/", c) >= 0
+}
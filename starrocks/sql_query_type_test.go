@@ -0,0 +1,45 @@
+package starrocks
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSQLQueryValue_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		new   string
+		equal bool
+	}{
+		{name: "identical", prior: "SELECT a FROM t", new: "SELECT a FROM t", equal: true},
+		{name: "line breaks", prior: "SELECT a,\n  b\nFROM t\nWHERE a = 1;\n", new: "SELECT a, b FROM t WHERE a=1", equal: true},
+		{name: "parentheses", prior: "SELECT count( * ) FROM t", new: "SELECT count(*) FROM t", equal: true},
+		{name: "string literal", prior: "SELECT 'a  b' FROM t", new: "SELECT 'a b' FROM t", equal: false},
+		{name: "escaped quote", prior: "SELECT 'it\\'s  x'", new: "SELECT 'it\\'s  x'", equal: true},
+		{name: "different query", prior: "SELECT a FROM t", new: "SELECT b FROM t", equal: false},
+		{name: "joined words", prior: "SELECT a b FROM t", new: "SELECT ab FROM t", equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewSQLQueryValue(tt.prior).StringSemanticEquals(context.Background(), NewSQLQueryValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.equal {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.new, equal, tt.equal)
+			}
+		})
+	}
+}
+
+func TestSQLQueryType_ValueType(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := (SQLQueryType{}).ValueType(ctx).(SQLQueryValue); !ok {
+		t.Error("ValueType() should return a SQLQueryValue")
+	}
+	if !NewSQLQueryValue("SELECT 1").Type(ctx).Equal(SQLQueryType{}) {
+		t.Error("SQLQueryValue.Type() should be SQLQueryType")
+	}
+}
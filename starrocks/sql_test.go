@@ -0,0 +1,127 @@
+package starrocks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"rg_test":           "`rg_test`",
+		"rg-with-hyphens":   "`rg-with-hyphens`",
+		"back`tick":         "`back``tick`",
+		"x`; DROP DATABASE": "`x``; DROP DATABASE`",
+		"":                  "``",
+	}
+
+	for input, want := range tests {
+		if got := quoteIdentifier(input); got != want {
+			t.Errorf("quoteIdentifier(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestQuoteQualifiedIdentifier(t *testing.T) {
+	if got, want := quoteQualifiedIdentifier("default_catalog", "db", "t`1"), "`default_catalog`.`db`.`t``1`"; got != want {
+		t.Errorf("quoteQualifiedIdentifier() = %s, want %s", got, want)
+	}
+	if got, want := quoteQualifiedIdentifier("", "db"), "`db`"; got != want {
+		t.Errorf("quoteQualifiedIdentifier() = %s, want %s", got, want)
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	tests := map[string]string{
+		"plain":                   `'plain'`,
+		"it's":                    `'it\'s'`,
+		`back\slash`:              `'back\\slash'`,
+		`\'`:                      `'\\\''`,
+		"x'); DROP USER root; --": `'x\'); DROP USER root; --'`,
+		"line\nbreak":             `'line\nbreak'`,
+		"nul\x00byte":             `'nul\0byte'`,
+	}
+
+	for input, want := range tests {
+		if got := quoteString(input); got != want {
+			t.Errorf("quoteString(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestQuoteProperty(t *testing.T) {
+	if got, want := quoteProperty("mem_limit", "80%"), `'mem_limit' = '80%'`; got != want {
+		t.Errorf("quoteProperty() = %s, want %s", got, want)
+	}
+	if got, want := quoteStrings([]string{"select", "in'sert"}), `'select', 'in\'sert'`; got != want {
+		t.Errorf("quoteStrings() = %s, want %s", got, want)
+	}
+}
+
+func TestParseProperties(t *testing.T) {
+	ddl := "CREATE DATABASE `db`\nPROPERTIES (\"replication_num\" = \"3\", \"comment\" = \"a \\\"quoted\\\" (value)\", 'single' = 'x')"
+
+	props := parseProperties(ddl)
+	want := map[string]string{
+		"replication_num": "3",
+		"comment":         `a "quoted" (value)`,
+		"single":          "x",
+	}
+	if len(props) != len(want) {
+		t.Fatalf("parseProperties() = %v, want %v", props, want)
+	}
+	for k, v := range want {
+		if props[k] != v {
+			t.Errorf("parseProperties()[%q] = %q, want %q", k, props[k], v)
+		}
+	}
+
+	if props := parseProperties("CREATE DATABASE `db`"); len(props) != 0 {
+		t.Errorf("parseProperties() without clause = %v, want empty", props)
+	}
+}
+
+func TestQuotePropertiesClause(t *testing.T) {
+	got := quotePropertiesClause(map[string]string{"b": "2", "a": "it's"})
+	if want := ` PROPERTIES ('a' = 'it\'s', 'b' = '2')`; got != want {
+		t.Errorf("quotePropertiesClause() = %s, want %s", got, want)
+	}
+	if got := quotePropertiesClause(nil); got != "" {
+		t.Errorf("quotePropertiesClause(nil) = %q, want empty", got)
+	}
+}
+
+func TestSplitNameList(t *testing.T) {
+	got := splitNameList(" 'a', `b,c` , d ")
+	want := []string{"'a'", "`b,c`", "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitNameList() = %q, want %q", got, want)
+	}
+	if got := splitNameList(""); got != nil {
+		t.Errorf("splitNameList(\"\") = %q, want nil", got)
+	}
+}
+
+func TestUnquoteName(t *testing.T) {
+	tests := map[string]string{
+		"'reader'":    "reader",
+		"`my``role`":  "my`role",
+		"plain":       "plain",
+		"'unbalanced": "'unbalanced",
+	}
+	for input, want := range tests {
+		if got := unquoteName(input); got != want {
+			t.Errorf("unquoteName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestChangedProperties(t *testing.T) {
+	before := map[string]string{"a": "1", "b": "2", "c": "3"}
+	after := map[string]string{"a": "1", "b": "20", "d": "4"}
+
+	got := changedProperties(before, after)
+	want := map[string]string{"b": "20", "d": "4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changedProperties() = %v, want %v", got, want)
+	}
+}
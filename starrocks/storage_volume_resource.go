@@ -0,0 +1,314 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &storageVolumeResource{}
+	_ resource.ResourceWithConfigure      = &storageVolumeResource{}
+	_ resource.ResourceWithImportState    = &storageVolumeResource{}
+	_ resource.ResourceWithValidateConfig = &storageVolumeResource{}
+	_ resource.ResourceWithModifyPlan     = &storageVolumeResource{}
+)
+
+func NewStorageVolumeResource() resource.Resource {
+	return &storageVolumeResource{}
+}
+
+type storageVolumeResource struct {
+	client *Client
+}
+
+type storageVolumeResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Locations   types.List   `tfsdk:"locations"`
+	Comment     types.String `tfsdk:"comment"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	Properties  types.Map    `tfsdk:"properties"`
+	Credentials types.Map    `tfsdk:"credentials"`
+}
+
+func (r *storageVolumeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_volume"
+}
+
+func (r *storageVolumeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks storage volume on a shared-data cluster.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(storageVolumeTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locations": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"is_default": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+			"credentials": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *storageVolumeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config storageVolumeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials := mapValues(config.Credentials)
+	for k := range mapValues(config.Properties) {
+		if k == "enabled" {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Property", `Use the enabled attribute instead of the "enabled" property.`)
+		}
+		if _, ok := credentials[k]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Property", fmt.Sprintf("Property %q is also set in credentials.", k))
+		}
+	}
+}
+
+func (r *storageVolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan storageVolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := mapValues(plan.Properties)
+	if props == nil {
+		props = make(map[string]string)
+	}
+	for k, v := range mapValues(plan.Credentials) {
+		props[k] = v
+	}
+
+	err := r.client.CreateStorageVolume(&StorageVolume{
+		Name:       plan.Name,
+		Type:       plan.Type,
+		Locations:  plan.Locations,
+		Comment:    plan.Comment,
+		Enabled:    plan.Enabled,
+		Properties: props,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Storage Volume", err.Error())
+		return
+	}
+
+	if plan.IsDefault.ValueBool() {
+		if err := r.client.SetDefaultStorageVolume(plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Default Storage Volume", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageVolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storageVolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err := r.client.GetStorageVolume(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading storage volume", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(volume)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refresh updates the model from the server. Credentials are masked by the
+// server and kept from the model.
+func (m *storageVolumeResourceModel) refresh(volume *StorageVolume) diag.Diagnostics {
+	m.Type = volume.Type
+	m.Locations = volume.Locations
+	m.Comment = volume.Comment
+	m.Enabled = volume.Enabled
+	m.IsDefault = volume.IsDefault
+
+	props := make(map[string]string, len(volume.Properties))
+	for k, v := range volume.Properties {
+		if !isMaskedValue(v) {
+			props[k] = v
+		}
+	}
+
+	var diags diag.Diagnostics
+	m.Properties, diags = trackedProperties(m.Properties, stringMap(props))
+	return diags
+}
+
+// ModifyPlan rejects clearing is_default. StarRocks always has a default
+// storage volume, which only changes when another one becomes the default.
+func (r *storageVolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state storageVolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IsDefault.IsUnknown() && !plan.IsDefault.ValueBool() && state.IsDefault.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_default"),
+			"Unable to Unset Default Storage Volume",
+			fmt.Sprintf("Storage volume %q is the default storage volume. Set is_default on another storage volume instead.", state.Name.ValueString()),
+		)
+	}
+}
+
+func (r *storageVolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state storageVolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	if !plan.Comment.Equal(state.Comment) {
+		if err := r.client.SetStorageVolumeComment(name, plan.Comment); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Storage Volume Comment", err.Error())
+			return
+		}
+	}
+
+	changed := changedProperties(mapValues(state.Properties), mapValues(plan.Properties))
+	maps.Copy(changed, changedProperties(mapValues(state.Credentials), mapValues(plan.Credentials)))
+	if !plan.Enabled.Equal(state.Enabled) {
+		changed["enabled"] = strconv.FormatBool(plan.Enabled.ValueBool())
+	}
+	if err := r.client.SetStorageVolumeProperties(name, changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Alter Storage Volume", err.Error())
+		return
+	}
+
+	if plan.IsDefault.ValueBool() && !state.IsDefault.ValueBool() {
+		if err := r.client.SetDefaultStorageVolume(name); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Default Storage Volume", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageVolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storageVolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropStorageVolume(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Storage Volume", err.Error())
+	}
+}
+
+func (r *storageVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volume, err := r.client.GetStorageVolume(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing storage volume", err.Error())
+		return
+	}
+
+	state := storageVolumeResourceModel{
+		Name:        volume.Name,
+		Properties:  types.MapNull(types.StringType),
+		Credentials: types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(state.refresh(volume)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageVolumeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,880 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &tableResource{}
+	_ resource.ResourceWithConfigure      = &tableResource{}
+	_ resource.ResourceWithImportState    = &tableResource{}
+	_ resource.ResourceWithValidateConfig = &tableResource{}
+)
+
+func NewTableResource() resource.Resource {
+	return &tableResource{}
+}
+
+type tableResource struct {
+	client *Client
+}
+
+type tableResourceModel struct {
+	Database     types.String `tfsdk:"database"`
+	Name         types.String `tfsdk:"name"`
+	Comment      types.String `tfsdk:"comment"`
+	Columns      types.List   `tfsdk:"columns"`
+	KeyType      types.String `tfsdk:"key_type"`
+	KeyColumns   types.List   `tfsdk:"key_columns"`
+	Partitioning types.Object `tfsdk:"partitioning"`
+	Distribution types.Object `tfsdk:"distribution"`
+	OrderBy      types.List   `tfsdk:"order_by"`
+	Properties   types.Map    `tfsdk:"properties"`
+}
+
+func (m *tableResourceModel) table() *Table {
+	return &Table{
+		Database:     m.Database,
+		Name:         m.Name,
+		Comment:      m.Comment,
+		Columns:      columnsFromList(m.Columns),
+		KeyType:      m.KeyType,
+		KeyColumns:   m.KeyColumns,
+		Partitioning: partitioningFromObject(m.Partitioning),
+		Distribution: distributionFromObject(m.Distribution),
+		OrderBy:      m.OrderBy,
+		Properties:   m.Properties,
+	}
+}
+
+func (r *tableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table"
+}
+
+func (r *tableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks OLAP table.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"columns": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(
+						requiresReplaceColumns,
+						"Removing a key column, changing its type or changing the aggregation of a column forces a new table.",
+						"Removing a key column, changing its type or changing the aggregation of a column forces a new table.",
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								columnTypeValidator{},
+							},
+						},
+						"nullable": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"default": schema.StringAttribute{
+							Optional: true,
+						},
+						"comment": schema.StringAttribute{
+							Optional: true,
+						},
+						"aggregation": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(columnAggregationTypes...),
+							},
+						},
+					},
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(tableKeyDuplicate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(tableKeyTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_columns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"partitioning": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(partitionRange, partitionList, partitionExpression),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"columns": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
+					},
+					"expression": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"partitions": schema.ListNestedAttribute{
+						Optional: true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplaceIf(
+								requiresReplacePartitions,
+								"Changing the values of a partition forces a new table.",
+								"Changing the values of a partition forces a new table.",
+							),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"values": schema.ListAttribute{
+									ElementType: types.StringType,
+									Required:    true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"distribution": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(distributionHash, distributionRandom),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"columns": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
+					},
+					"buckets": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"order_by": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceColumns reports column changes that ALTER TABLE cannot
+// apply: dropping or retyping a key column, and changing an aggregation.
+func requiresReplaceColumns(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	var keyColumns types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("key_columns"), &keyColumns)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys := make(map[string]bool)
+	for _, k := range listValues(keyColumns) {
+		keys[k] = true
+	}
+
+	planned := make(map[string]TableColumn)
+	for _, col := range columnsFromList(req.PlanValue) {
+		planned[col.Name.ValueString()] = col
+	}
+
+	for _, col := range columnsFromList(req.StateValue) {
+		name := col.Name.ValueString()
+		next, ok := planned[name]
+		if keys[name] && (!ok || normalizeColumnType(next.Type.ValueString()) != normalizeColumnType(col.Type.ValueString())) {
+			resp.RequiresReplace = true
+			return
+		}
+		if ok && !strings.EqualFold(next.Aggregation.ValueString(), col.Aggregation.ValueString()) {
+			resp.RequiresReplace = true
+			return
+		}
+	}
+}
+
+// requiresReplacePartitions reports partitions whose values change. ALTER
+// TABLE could only drop and add them again, deleting their data.
+func requiresReplacePartitions(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	planned := make(map[string]TablePartition)
+	for _, part := range partitionsFromList(req.PlanValue) {
+		planned[part.Name.ValueString()] = part
+	}
+
+	for _, part := range partitionsFromList(req.StateValue) {
+		next, ok := planned[part.Name.ValueString()]
+		if ok && (next.Values.IsUnknown() || !samePartitionValues(listValues(part.Values), listValues(next.Values))) {
+			resp.RequiresReplace = true
+			return
+		}
+	}
+}
+
+func (r *tableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	columns := make(map[string]bool)
+	if !config.Columns.IsUnknown() {
+		for _, col := range columnsFromList(config.Columns) {
+			if col.Name.IsUnknown() {
+				return
+			}
+			name := col.Name.ValueString()
+			if columns[name] {
+				resp.Diagnostics.AddAttributeError(path.Root("columns"), "Duplicate Column", fmt.Sprintf("Column %q is defined more than once.", name))
+			}
+			columns[name] = true
+
+			if !col.Aggregation.IsNull() && !config.KeyType.IsUnknown() && config.KeyType.ValueString() != tableKeyAggregate {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("columns"),
+					"Unsupported Column Aggregation",
+					fmt.Sprintf("Column %q has an aggregation, which requires key_type %s.", name, tableKeyAggregate),
+				)
+			}
+		}
+	}
+
+	checkColumns := func(p path.Path, list types.List) {
+		if config.Columns.IsUnknown() {
+			return
+		}
+		for _, name := range listValues(list) {
+			if !columns[name] {
+				resp.Diagnostics.AddAttributeError(p, "Unknown Column", fmt.Sprintf("Column %q is not defined in columns.", name))
+			}
+		}
+	}
+	checkColumns(path.Root("key_columns"), config.KeyColumns)
+	checkColumns(path.Root("order_by"), config.OrderBy)
+
+	if d := distributionFromObject(config.Distribution); d != nil && !d.Type.IsUnknown() && !d.Columns.IsUnknown() {
+		p := path.Root("distribution").AtName("columns")
+		switch {
+		case d.Type.ValueString() == distributionHash && d.Columns.IsNull():
+			resp.Diagnostics.AddAttributeError(p, "Missing Distribution Columns", "HASH distribution requires columns.")
+		case d.Type.ValueString() == distributionRandom && !d.Columns.IsNull():
+			resp.Diagnostics.AddAttributeError(p, "Unexpected Distribution Columns", "RANDOM distribution does not take columns.")
+		}
+		checkColumns(p, d.Columns)
+	}
+
+	if pt := partitioningFromObject(config.Partitioning); pt != nil && !pt.Type.IsUnknown() && !pt.Columns.IsUnknown() && !pt.Expression.IsUnknown() {
+		p := path.Root("partitioning")
+		switch pt.Type.ValueString() {
+		case partitionExpression:
+			if pt.Columns.IsNull() == pt.Expression.IsNull() {
+				resp.Diagnostics.AddAttributeError(p, "Invalid Partitioning", "EXPRESSION partitioning requires exactly one of columns and expression.")
+			}
+			if len(pt.Partitions) > 0 {
+				resp.Diagnostics.AddAttributeError(p.AtName("partitions"), "Invalid Partitioning", "EXPRESSION partitioning creates partitions automatically and does not take partitions.")
+			}
+		default:
+			if pt.Columns.IsNull() || !pt.Expression.IsNull() {
+				resp.Diagnostics.AddAttributeError(p, "Invalid Partitioning", fmt.Sprintf("%s partitioning requires columns and does not take an expression.", pt.Type.ValueString()))
+			}
+			if pt.Type.ValueString() == partitionList && len(listValues(pt.Columns)) > 1 {
+				resp.Diagnostics.AddAttributeError(p.AtName("columns"), "Invalid Partitioning", "LIST partitioning supports a single column.")
+			}
+		}
+		checkColumns(p.AtName("columns"), pt.Columns)
+	}
+}
+
+func (r *tableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateTable(plan.table()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Table", err.Error())
+		return
+	}
+
+	// Fill in the key columns and bucket count chosen by the server.
+	table, err := r.client.GetTable(plan.Database.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading table", err.Error())
+		return
+	}
+	if plan.KeyColumns.IsUnknown() {
+		plan.KeyColumns = table.KeyColumns
+	}
+	if d := distributionFromObject(plan.Distribution); d != nil && d.Buckets.IsUnknown() {
+		d.Buckets = types.Int64Null()
+		if table.Distribution != nil {
+			d.Buckets = table.Distribution.Buckets
+		}
+		plan.Distribution = distributionToObject(d)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := r.client.GetTable(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading table", err.Error())
+		return
+	}
+
+	state.Comment = table.Comment
+	state.Columns = columnsToList(mergeColumns(columnsFromList(state.Columns), table.Columns))
+	state.KeyType = table.KeyType
+	state.KeyColumns = table.KeyColumns
+
+	if d := distributionFromObject(state.Distribution); d != nil && table.Distribution != nil {
+		d.Type = table.Distribution.Type
+		d.Columns = table.Distribution.Columns
+		if !table.Distribution.Buckets.IsNull() {
+			d.Buckets = table.Distribution.Buckets
+		}
+		state.Distribution = distributionToObject(d)
+	}
+
+	if pt := partitioningFromObject(state.Partitioning); pt != nil {
+		state.Partitioning = partitioningToObject(mergePartitioning(pt, table.Partitioning))
+	}
+
+	// SHOW CREATE TABLE omits ORDER BY when it matches the key.
+	if !table.OrderBy.IsNull() || !state.OrderBy.Equal(state.KeyColumns) {
+		state.OrderBy = table.OrderBy
+	}
+
+	// Only properties that are configured are tracked; the server reports
+	// many more defaults.
+	var diags diag.Diagnostics
+	state.Properties, diags = trackedProperties(state.Properties, table.Properties)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// mergeColumns returns the columns of a table in the order of prior, with
+// the configured spelling of equivalent types, followed by columns added
+// outside Terraform.
+func mergeColumns(prior, current []TableColumn) []TableColumn {
+	byName := make(map[string]TableColumn, len(current))
+	for _, col := range current {
+		byName[col.Name.ValueString()] = col
+	}
+
+	merged := make([]TableColumn, 0, len(current))
+	seen := make(map[string]bool)
+	for _, col := range prior {
+		name := col.Name.ValueString()
+		server, ok := byName[name]
+		if !ok {
+			continue
+		}
+		seen[name] = true
+		if normalizeColumnType(col.Type.ValueString()) == normalizeColumnType(server.Type.ValueString()) {
+			server.Type = col.Type
+		}
+		if strings.EqualFold(col.Aggregation.ValueString(), server.Aggregation.ValueString()) {
+			server.Aggregation = col.Aggregation
+		}
+		merged = append(merged, server)
+	}
+	for _, col := range current {
+		if !seen[col.Name.ValueString()] {
+			merged = append(merged, col)
+		}
+	}
+	return merged
+}
+
+// mergePartitioning returns the partitioning of a table with the configured
+// spelling of equivalent columns, expressions and values. Partitions are
+// kept in the order of prior, followed by partitions added outside
+// Terraform.
+func mergePartitioning(prior, current *TablePartitioning) *TablePartitioning {
+	if current == nil {
+		return nil
+	}
+
+	merged := *current
+	if strings.EqualFold(prior.Type.ValueString(), current.Type.ValueString()) {
+		merged.Type = prior.Type
+	}
+	if sameNames(listValues(prior.Columns), listValues(current.Columns)) {
+		merged.Columns = prior.Columns
+	}
+	if !prior.Expression.IsNull() && !current.Expression.IsNull() && sameExpression(prior.Expression.ValueString(), current.Expression.ValueString()) {
+		merged.Expression = prior.Expression
+	}
+
+	byName := make(map[string]TablePartition, len(current.Partitions))
+	for _, part := range current.Partitions {
+		byName[part.Name.ValueString()] = part
+	}
+
+	merged.Partitions = nil
+	seen := make(map[string]bool)
+	for _, part := range prior.Partitions {
+		name := part.Name.ValueString()
+		server, ok := byName[name]
+		if !ok {
+			continue
+		}
+		seen[name] = true
+		if samePartitionValues(listValues(part.Values), listValues(server.Values)) {
+			server.Values = part.Values
+		}
+		merged.Partitions = append(merged.Partitions, server)
+	}
+	for _, part := range current.Partitions {
+		if !seen[part.Name.ValueString()] {
+			merged.Partitions = append(merged.Partitions, part)
+		}
+	}
+	return &merged
+}
+
+// sameNames reports whether two lists of identifiers match, ignoring case.
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// samePartitionValues reports whether two partition value tuples match. The
+// server prints DATETIME bounds with a time, so "2024-01-01" and
+// "2024-01-01 00:00:00" are the same value.
+func samePartitionValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := strings.TrimSuffix(a[i], " 00:00:00"), strings.TrimSuffix(b[i], " 00:00:00")
+		if !strings.EqualFold(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// trackedProperties returns the server values of the properties in prior.
+func trackedProperties(prior, current types.Map) (types.Map, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+
+	serverProps := mapValues(current)
+	elems := make(map[string]attr.Value, len(prior.Elements()))
+	for k, v := range prior.Elements() {
+		if sv, ok := serverProps[k]; ok {
+			elems[k] = types.StringValue(sv)
+		} else {
+			elems[k] = v
+		}
+	}
+	return types.MapValue(types.StringType, elems)
+}
+
+// untrackedPropertiesWarning warns when properties are removed from
+// configuration. StarRocks keeps their values, which trackedProperties then
+// no longer reports.
+type untrackedPropertiesWarning struct{}
+
+var _ planmodifier.Map = untrackedPropertiesWarning{}
+
+func (m untrackedPropertiesWarning) Description(_ context.Context) string {
+	return "Removing a property from configuration does not reset it on the server."
+}
+
+func (m untrackedPropertiesWarning) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m untrackedPropertiesWarning) PlanModifyMap(_ context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned := req.PlanValue.Elements()
+	var removed []string
+	for k := range req.StateValue.Elements() {
+		if _, ok := planned[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+	sort.Strings(removed)
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Properties Not Reset",
+		fmt.Sprintf("Removing %s from configuration does not reset them on the server. They keep their current values and are no longer tracked.", quoteStrings(removed)),
+	)
+}
+
+func (r *tableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db, name := plan.Database.ValueString(), plan.Name.ValueString()
+
+	// alter runs an ALTER TABLE statement and waits for the job of the given
+	// kind that it starts. The latest job is recorded first, so that earlier
+	// jobs, including cancelled ones, are not waited for.
+	alter := func(kind, summary string, run func() error) bool {
+		since, err := r.client.LatestTableAlterJobID(db, name, kind)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Alter Table", err.Error())
+			return false
+		}
+		if err := run(); err != nil {
+			resp.Diagnostics.AddError(summary, err.Error())
+			return false
+		}
+		if err := r.client.WaitForTableAlter(ctx, db, name, kind, since); err != nil {
+			resp.Diagnostics.AddError("Unable to Alter Table", err.Error())
+			return false
+		}
+		return true
+	}
+
+	if !plan.Comment.Equal(state.Comment) {
+		if err := r.client.SetTableComment(db, name, plan.Comment); err != nil {
+			resp.Diagnostics.AddError("Unable to Set Table Comment", err.Error())
+			return
+		}
+	}
+
+	if !plan.Columns.Equal(state.Columns) {
+		if !r.updateColumns(db, name, columnsFromList(state.Columns), columnsFromList(plan.Columns), alter) {
+			return
+		}
+	}
+
+	if !plan.Partitioning.Equal(state.Partitioning) {
+		if !r.updatePartitions(db, name, partitioningFromObject(state.Partitioning), partitioningFromObject(plan.Partitioning), &resp.Diagnostics) {
+			return
+		}
+	}
+
+	planDist, stateDist := distributionFromObject(plan.Distribution), distributionFromObject(state.Distribution)
+	if planDist != nil && stateDist != nil && !planDist.Buckets.IsNull() && !planDist.Buckets.Equal(stateDist.Buckets) {
+		if !alter(tableAlterOptimize, "Unable to Set Table Distribution", func() error {
+			return r.client.SetTableDistribution(db, name, planDist)
+		}) {
+			return
+		}
+	}
+
+	if !plan.OrderBy.Equal(state.OrderBy) && !plan.OrderBy.IsNull() {
+		if !alter(tableAlterColumn, "Unable to Set Table Sort Key", func() error {
+			return r.client.SetTableOrderBy(db, name, listValues(plan.OrderBy))
+		}) {
+			return
+		}
+	}
+
+	changed := changedProperties(mapValues(state.Properties), mapValues(plan.Properties))
+	if err := r.client.SetTableProperties(db, name, changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Set Table Properties", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateColumns drops, adds and modifies columns to turn before into after.
+// Each change waits for the schema change job it starts.
+func (r *tableResource) updateColumns(db, name string, before, after []TableColumn, alter func(kind, summary string, run func() error) bool) bool {
+	beforeByName := make(map[string]TableColumn, len(before))
+	for _, col := range before {
+		beforeByName[col.Name.ValueString()] = col
+	}
+	afterByName := make(map[string]bool, len(after))
+	for _, col := range after {
+		afterByName[col.Name.ValueString()] = true
+	}
+
+	for _, col := range before {
+		if afterByName[col.Name.ValueString()] {
+			continue
+		}
+		if !alter(tableAlterColumn, "Unable to Drop Column", func() error {
+			return r.client.DropTableColumn(db, name, col.Name.ValueString())
+		}) {
+			return false
+		}
+	}
+
+	for i, col := range after {
+		old, exists := beforeByName[col.Name.ValueString()]
+		var run func() error
+		switch {
+		case !exists:
+			first, previous := addColumnPosition(after, i)
+			run = func() error { return r.client.AddTableColumn(db, name, col, first, previous) }
+		case columnChanged(old, col):
+			run = func() error { return r.client.ModifyTableColumn(db, name, col) }
+		default:
+			continue
+		}
+		if !alter(tableAlterColumn, "Unable to Alter Column", run) {
+			return false
+		}
+	}
+	return true
+}
+
+// addColumnPosition returns where the i-th column of columns is added:
+// first, after the column before it, or at the end for the last column.
+func addColumnPosition(columns []TableColumn, i int) (bool, string) {
+	switch {
+	case i == len(columns)-1:
+		return false, ""
+	case i == 0:
+		return true, ""
+	}
+	return false, columns[i-1].Name.ValueString()
+}
+
+// columnChanged reports whether a column needs MODIFY COLUMN.
+func columnChanged(before, after TableColumn) bool {
+	return normalizeColumnType(before.Type.ValueString()) != normalizeColumnType(after.Type.ValueString()) ||
+		!before.Nullable.Equal(after.Nullable) ||
+		!before.Default.Equal(after.Default) ||
+		!before.Comment.Equal(after.Comment)
+}
+
+// updatePartitions drops and adds range or list partitions by name. Changed
+// values force a new table; see requiresReplacePartitions.
+func (r *tableResource) updatePartitions(db, name string, before, after *TablePartitioning, diags *diag.Diagnostics) bool {
+	if before == nil || after == nil {
+		return true
+	}
+
+	afterByName := make(map[string]TablePartition, len(after.Partitions))
+	for _, part := range after.Partitions {
+		afterByName[part.Name.ValueString()] = part
+	}
+	beforeByName := make(map[string]TablePartition, len(before.Partitions))
+	for _, part := range before.Partitions {
+		beforeByName[part.Name.ValueString()] = part
+		if _, ok := afterByName[part.Name.ValueString()]; ok {
+			continue
+		}
+		if err := r.client.DropTablePartition(db, name, part.Name.ValueString()); err != nil {
+			diags.AddError("Unable to Drop Partition", err.Error())
+			return false
+		}
+	}
+
+	for _, part := range after.Partitions {
+		if _, ok := beforeByName[part.Name.ValueString()]; ok {
+			continue
+		}
+		if err := r.client.AddTablePartition(db, name, after.Type.ValueString(), part); err != nil {
+			diags.AddError("Unable to Add Partition", err.Error())
+			return false
+		}
+	}
+	return true
+}
+
+func (r *tableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropTable(state.Database.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Table", err.Error())
+	}
+}
+
+func (r *tableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<table>, got: %q", req.ID))
+		return
+	}
+
+	table, err := r.client.GetTable(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing table", err.Error())
+		return
+	}
+
+	state := tableResourceModel{
+		Database:     table.Database,
+		Name:         table.Name,
+		Comment:      table.Comment,
+		Columns:      columnsToList(table.Columns),
+		KeyType:      table.KeyType,
+		KeyColumns:   table.KeyColumns,
+		Partitioning: partitioningToObject(table.Partitioning),
+		Distribution: distributionToObject(table.Distribution),
+		OrderBy:      table.OrderBy,
+		Properties:   types.MapNull(types.StringType),
+	}
+	if state.OrderBy.Equal(state.KeyColumns) {
+		state.OrderBy = types.ListNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *tableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
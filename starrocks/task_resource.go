@@ -0,0 +1,293 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &taskResource{}
+	_ resource.ResourceWithConfigure   = &taskResource{}
+	_ resource.ResourceWithImportState = &taskResource{}
+)
+
+func NewTaskResource() resource.Resource {
+	return &taskResource{}
+}
+
+type taskResource struct {
+	client *Client
+}
+
+type taskResourceModel struct {
+	Database            types.String  `tfsdk:"database"`
+	Name                types.String  `tfsdk:"name"`
+	Query               SQLQueryValue `tfsdk:"query"`
+	Schedule            types.Object  `tfsdk:"schedule"`
+	Properties          types.Map     `tfsdk:"properties"`
+	LastRunState        types.String  `tfsdk:"last_run_state"`
+	LastRunCreateTime   types.String  `tfsdk:"last_run_create_time"`
+	LastRunFinishTime   types.String  `tfsdk:"last_run_finish_time"`
+	LastRunErrorMessage types.String  `tfsdk:"last_run_error_message"`
+}
+
+func (m *taskResourceModel) task() *Task {
+	return &Task{
+		Database:   m.Database,
+		Name:       m.Name,
+		Query:      m.Query.StringValue,
+		Schedule:   taskScheduleFromObject(m.Schedule),
+		Properties: m.Properties,
+	}
+}
+
+// setLastRun sets the computed attributes of the latest run, which are null
+// when the task has not run yet.
+func (m *taskResourceModel) setLastRun(run *TaskRun) {
+	if run == nil {
+		run = &TaskRun{}
+	}
+	m.LastRunState = run.State
+	m.LastRunCreateTime = run.CreateTime
+	m.LastRunFinishTime = run.FinishTime
+	m.LastRunErrorMessage = run.ErrorMessage
+}
+
+// refresh updates the model from the server. The schedule start time is not
+// reported in a comparable form and is kept.
+func (m *taskResourceModel) refresh(t *Task) diag.Diagnostics {
+	// SQLQueryValue keeps the configured text when only whitespace differs.
+	m.Query = SQLQueryValue{StringValue: t.Query}
+
+	schedule := taskScheduleFromObject(m.Schedule)
+	switch {
+	case t.Schedule == nil:
+		schedule = nil
+	case schedule == nil:
+		schedule = t.Schedule
+	case !strings.EqualFold(schedule.Interval.ValueString(), t.Schedule.Interval.ValueString()):
+		schedule.Interval = t.Schedule.Interval
+	}
+	m.Schedule = taskScheduleToObject(schedule)
+
+	var diags diag.Diagnostics
+	m.Properties, diags = trackedProperties(m.Properties, t.Properties)
+	return diags
+}
+
+func (r *taskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task"
+}
+
+func (r *taskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks task submitted with SUBMIT TASK, optionally on a schedule.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				CustomType: SQLQueryType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"interval": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(refreshIntervalPattern, "must be a number followed by SECOND, MINUTE, HOUR, DAY, WEEK, MONTH or YEAR, e.g. \"1 DAY\""),
+						},
+					},
+				},
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_run_state": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_run_create_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_run_finish_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_run_error_message": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *taskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan taskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SubmitTask(plan.task()); err != nil {
+		resp.Diagnostics.AddError("Unable to Submit Task", err.Error())
+		return
+	}
+
+	run, err := r.client.GetLastTaskRun(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading task runs", err.Error())
+		return
+	}
+	plan.setLastRun(run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *taskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state taskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetTask(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading task", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(state.refresh(task)...)
+
+	run, err := r.client.GetLastTaskRun(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading task runs", err.Error())
+		return
+	}
+	state.setLastRun(run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *taskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state taskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tasks cannot be altered, so they are replaced in place.
+	if !plan.Query.Equal(state.Query) || !plan.Schedule.Equal(state.Schedule) || !plan.Properties.Equal(state.Properties) {
+		if err := r.client.DropTask(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+			resp.Diagnostics.AddError("Unable to Drop Task", err.Error())
+			return
+		}
+		if err := r.client.SubmitTask(plan.task()); err != nil {
+			resp.Diagnostics.AddError("Unable to Submit Task", err.Error())
+			return
+		}
+	}
+
+	run, err := r.client.GetLastTaskRun(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading task runs", err.Error())
+		return
+	}
+	plan.setLastRun(run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *taskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state taskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropTask(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Task", err.Error())
+	}
+}
+
+func (r *taskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<name>, got: %q", req.ID))
+		return
+	}
+
+	task, err := r.client.GetTask(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing task", err.Error())
+		return
+	}
+
+	state := taskResourceModel{
+		Database:   task.Database,
+		Name:       task.Name,
+		Schedule:   types.ObjectNull(taskScheduleAttrTypes),
+		Properties: types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(state.refresh(task)...)
+
+	run, err := r.client.GetLastTaskRun(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading task runs", err.Error())
+		return
+	}
+	state.setLastRun(run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *taskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,308 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &userResource{}
+	_ resource.ResourceWithConfigure        = &userResource{}
+	_ resource.ResourceWithImportState      = &userResource{}
+	_ resource.ResourceWithConfigValidators = &userResource{}
+	_ resource.ResourceWithValidateConfig   = &userResource{}
+)
+
+// nativePasswordHash matches the output of PASSWORD(), e.g. "*6BB4...".
+var nativePasswordHash = regexp.MustCompile(`^\*[0-9A-Fa-f]{40}$`)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	client *Client
+}
+
+type userResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Host         types.String `tfsdk:"host"`
+	Password     types.String `tfsdk:"password"`
+	PasswordHash types.String `tfsdk:"password_hash"`
+	AuthPlugin   types.String `tfsdk:"auth_plugin"`
+	LDAPDN       types.String `tfsdk:"ldap_dn"`
+	DefaultRoles types.Set    `tfsdk:"default_roles"`
+}
+
+func (m *userResourceModel) GetName() types.String         { return m.Name }
+func (m *userResourceModel) GetHost() types.String         { return m.Host }
+func (m *userResourceModel) GetPassword() types.String     { return m.Password }
+func (m *userResourceModel) GetPasswordHash() types.String { return m.PasswordHash }
+func (m *userResourceModel) GetAuthPlugin() types.String   { return m.AuthPlugin }
+func (m *userResourceModel) GetLDAPDN() types.String       { return m.LDAPDN }
+func (m *userResourceModel) GetDefaultRoles() types.Set    { return m.DefaultRoles }
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks user.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultUserHost),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_hash": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(nativePasswordHash, "must be a mysql_native_password hash such as the output of PASSWORD()"),
+				},
+			},
+			"auth_plugin": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(authPluginNativePassword),
+				Validators: []validator.String{
+					stringvalidator.OneOf(authPluginNativePassword, authPluginLDAPSimple),
+				},
+			},
+			"ldap_dn": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *userResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_hash"),
+			path.MatchRoot("ldap_dn"),
+		),
+	}
+}
+
+func (r *userResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.AuthPlugin.IsUnknown() {
+		return
+	}
+
+	if config.AuthPlugin.ValueString() == authPluginLDAPSimple {
+		for _, attr := range []string{"password", "password_hash"} {
+			var v types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &v)...)
+			if !v.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr),
+					"Unsupported User Credentials",
+					fmt.Sprintf("%s cannot be used with the %s authentication plugin.", attr, authPluginLDAPSimple),
+				)
+			}
+		}
+		return
+	}
+
+	if !config.LDAPDN.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ldap_dn"),
+			"Unsupported User Credentials",
+			fmt.Sprintf("ldap_dn requires the %s authentication plugin.", authPluginLDAPSimple),
+		)
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateUser(&plan); err != nil {
+		resp.Diagnostics.AddError("Unable to Create User", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(state.Name.ValueString(), state.Host.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	state.AuthPlugin = user.AuthPlugin
+	if user.AuthPlugin.ValueString() == authPluginLDAPSimple {
+		state.LDAPDN = user.LDAPDN
+	} else {
+		state.LDAPDN = types.StringNull()
+	}
+
+	// Passwords cannot be read back, but a password that was removed on the
+	// server is drift worth reporting.
+	if !user.HasPassword {
+		state.Password = types.StringNull()
+		state.PasswordHash = types.StringNull()
+	}
+
+	if !state.DefaultRoles.IsNull() {
+		defaults, err := r.client.GetUserDefaultRoles(state.Name.ValueString(), state.Host.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading default roles", err.Error())
+			return
+		}
+		state.DefaultRoles = stringSet(defaults)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Password.Equal(state.Password) ||
+		!plan.PasswordHash.Equal(state.PasswordHash) ||
+		!plan.AuthPlugin.Equal(state.AuthPlugin) ||
+		!plan.LDAPDN.Equal(state.LDAPDN) {
+		if err := r.client.AlterUserAuthentication(&plan); err != nil {
+			resp.Diagnostics.AddError("Unable to Alter User", err.Error())
+			return
+		}
+	}
+
+	if !plan.DefaultRoles.Equal(state.DefaultRoles) {
+		if err := r.client.SetUserDefaultRoles(plan.Name.ValueString(), plan.Host.ValueString(), plan.DefaultRoles); err != nil {
+			resp.Diagnostics.AddError("Unable to Set User Default Roles", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropUser(state.Name.ValueString(), state.Host.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop User", err.Error())
+	}
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, host, err := parseUserIdentity(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a user identity such as 'name'@'host': %v", err),
+		)
+		return
+	}
+
+	user, err := r.client.GetUser(name, host)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing user", err.Error())
+		return
+	}
+
+	state := userResourceModel{
+		Name:         user.Name,
+		Host:         user.Host,
+		Password:     types.StringNull(),
+		PasswordHash: types.StringNull(),
+		AuthPlugin:   user.AuthPlugin,
+		LDAPDN:       types.StringNull(),
+		DefaultRoles: types.SetNull(types.StringType),
+	}
+	if user.AuthPlugin.ValueString() == authPluginLDAPSimple {
+		state.LDAPDN = user.LDAPDN
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
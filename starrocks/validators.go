@@ -0,0 +1,157 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = percentageValidator{}
+	_ validator.String = cidrValidator{}
+	_ validator.String = costRangeValidator{}
+	_ validator.String = durationValidator{}
+	_ validator.String = columnTypeValidator{}
+)
+
+// percentageValidator checks that a string is a percentage in (0, 100], such
+// as "80%" or "80.0%".
+type percentageValidator struct{}
+
+func (v percentageValidator) Description(_ context.Context) string {
+	return "value must be a percentage greater than 0% and at most 100%, e.g. \"80%\""
+}
+
+func (v percentageValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v percentageValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	percent, err := parsePercentage(value)
+	if err != nil || percent <= 0 || percent > 100 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Percentage",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// cidrValidator checks that a string is an IP address or a CIDR block.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return "value must be an IP address or a CIDR block, e.g. \"192.168.1.0/24\""
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+	if net.ParseIP(value) != nil {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid CIDR Block",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+	)
+}
+
+// costRangeValidator checks that a string is a plan cost range such as
+// "[1, 100)" with a lower bound not above the upper bound.
+type costRangeValidator struct{}
+
+func (v costRangeValidator) Description(_ context.Context) string {
+	return "value must be a range such as \"[1, 100)\" with brackets or parentheses around two numbers"
+}
+
+func (v costRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v costRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, lo, hi, _, ok := parseCostRange(value); !ok || lo > hi {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cost Range",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// durationValidator checks that a string is a positive Go duration such as
+// "10s" or "1m30s".
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as \"30s\" or \"1m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// columnTypeValidator checks that a string is a single column type such as
+// "VARCHAR(64)" or "ARRAY<INT>".
+type columnTypeValidator struct{}
+
+func (v columnTypeValidator) Description(_ context.Context) string {
+	return "value must be a column type, e.g. \"VARCHAR(64)\" or \"DECIMAL(10, 2)\""
+}
+
+func (v columnTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v columnTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !validColumnType(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Column Type",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,52 @@
+package starrocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantError bool
+	}{
+		{name: "percentage", validator: percentageValidator{}, value: types.StringValue("80%")},
+		{name: "percentage decimal", validator: percentageValidator{}, value: types.StringValue("80.5%")},
+		{name: "percentage without sign", validator: percentageValidator{}, value: types.StringValue("80"), wantError: true},
+		{name: "percentage above 100", validator: percentageValidator{}, value: types.StringValue("120%"), wantError: true},
+		{name: "percentage zero", validator: percentageValidator{}, value: types.StringValue("0%"), wantError: true},
+		{name: "percentage null", validator: percentageValidator{}, value: types.StringNull()},
+		{name: "cidr", validator: cidrValidator{}, value: types.StringValue("192.168.1.0/24")},
+		{name: "cidr ipv6", validator: cidrValidator{}, value: types.StringValue("2001:db8::/32")},
+		{name: "cidr plain ip", validator: cidrValidator{}, value: types.StringValue("10.0.0.1")},
+		{name: "cidr invalid", validator: cidrValidator{}, value: types.StringValue("10.0.0.0/33"), wantError: true},
+		{name: "cidr unknown", validator: cidrValidator{}, value: types.StringUnknown()},
+		{name: "cost range", validator: costRangeValidator{}, value: types.StringValue("[1, 100)")},
+		{name: "cost range inverted", validator: costRangeValidator{}, value: types.StringValue("[100, 1)"), wantError: true},
+		{name: "cost range malformed", validator: costRangeValidator{}, value: types.StringValue("1-100"), wantError: true},
+		{name: "duration", validator: durationValidator{}, value: types.StringValue("1m30s")},
+		{name: "duration without unit", validator: durationValidator{}, value: types.StringValue("30"), wantError: true},
+		{name: "duration negative", validator: durationValidator{}, value: types.StringValue("-5s"), wantError: true},
+		{name: "column type", validator: columnTypeValidator{}, value: types.StringValue("DECIMAL(10, 2)")},
+		{name: "column type nested", validator: columnTypeValidator{}, value: types.StringValue("MAP<INT, ARRAY<VARCHAR(10)>>")},
+		{name: "column type injected", validator: columnTypeValidator{}, value: types.StringValue("VARCHAR(10) NOT NULL, x INT (1)"), wantError: true},
+		{name: "column type statement", validator: columnTypeValidator{}, value: types.StringValue("INT); DROP TABLE t; --"), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateString(%v) error = %v, want %v", tt.value, resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = VariableValueType{}
+	_ basetypes.StringValuableWithSemanticEquals = VariableValue{}
+)
+
+// VariableValueType is a string type for system variable values. StarRocks
+// reports values in its own spelling (e.g. "ON" is reported back as "true"),
+// so booleans and numbers are compared by value.
+type VariableValueType struct {
+	basetypes.StringType
+}
+
+func (t VariableValueType) String() string {
+	return "VariableValueType"
+}
+
+func (t VariableValueType) Equal(o attr.Type) bool {
+	other, ok := o.(VariableValueType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t VariableValueType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return VariableValue{StringValue: in}, nil
+}
+
+func (t VariableValueType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t VariableValueType) ValueType(_ context.Context) attr.Value {
+	return VariableValue{}
+}
+
+// VariableValue is the value of a VariableValueType attribute.
+type VariableValue struct {
+	basetypes.StringValue
+}
+
+func NewVariableValue(value string) VariableValue {
+	return VariableValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewVariableNull() VariableValue {
+	return VariableValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewVariableUnknown() VariableValue {
+	return VariableValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+func (v VariableValue) Type(_ context.Context) attr.Type {
+	return VariableValueType{}
+}
+
+func (v VariableValue) Equal(o attr.Value) bool {
+	other, ok := o.(VariableValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values denote the same variable
+// value, e.g. "ON", "1" and "true".
+func (v VariableValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(VariableValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return variableValuesEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// variableValuesEqual compares two variable values. Boolean spellings
+// (true/false, on/off, 1/0) and numbers are compared by value, anything else
+// case-insensitively.
+func variableValuesEqual(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+
+	if x, ok := parseVariableBool(a); ok {
+		if y, ok := parseVariableBool(b); ok {
+			return x == y
+		}
+	}
+
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return x == y
+		}
+	}
+
+	return strings.EqualFold(a, b)
+}
+
+// parseVariableBool parses the boolean spellings accepted by SET.
+func parseVariableBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "true", "on", "1":
+		return true, true
+	case "false", "off", "0":
+		return false, true
+	}
+	return false, false
+}
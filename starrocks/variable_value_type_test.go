@@ -0,0 +1,38 @@
+package starrocks
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVariableValue_StringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		new   string
+		equal bool
+	}{
+		{name: "identical", prior: "300", new: "300", equal: true},
+		{name: "boolean keyword", prior: "ON", new: "true", equal: true},
+		{name: "boolean number", prior: "1", new: "true", equal: true},
+		{name: "boolean false", prior: "off", new: "0", equal: true},
+		{name: "boolean mismatch", prior: "true", new: "false", equal: false},
+		{name: "number formatting", prior: "0.5", new: "0.50", equal: true},
+		{name: "number mismatch", prior: "300", new: "600", equal: false},
+		{name: "number is not a boolean", prior: "2", new: "true", equal: false},
+		{name: "string case", prior: "only_full_group_by", new: "ONLY_FULL_GROUP_BY", equal: true},
+		{name: "string mismatch", prior: "Asia/Shanghai", new: "UTC", equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := NewVariableValue(tt.prior).StringSemanticEquals(context.Background(), NewVariableValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.equal {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.new, equal, tt.equal)
+			}
+		})
+	}
+}
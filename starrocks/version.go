@@ -0,0 +1,59 @@
+package starrocks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// minSupportedVersion is the oldest StarRocks release the provider is tested
+// against.
+var minSupportedVersion = Version{Major: 3, Minor: 0}
+
+// Version is a parsed StarRocks server version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	// Raw is the version string as reported by current_version(), e.g.
+	// "3.3.5-6d81f75".
+	Raw string
+}
+
+var versionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// ParseVersion parses the output of SELECT current_version().
+func ParseVersion(s string) (Version, error) {
+	m := versionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Version{Raw: s}, fmt.Errorf("unrecognized StarRocks version %q", s)
+	}
+
+	v := Version{Raw: s}
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	if m[3] != "" {
+		v.Patch, _ = strconv.Atoi(m[3])
+	}
+	return v, nil
+}
+
+// AtLeast reports whether v is the given release or newer.
+func (v Version) AtLeast(major, minor, patch int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+	return v.Patch >= patch
+}
+
+// IsZero reports whether the version is unknown.
+func (v Version) IsZero() bool {
+	return v.Major == 0 && v.Minor == 0 && v.Patch == 0
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
@@ -0,0 +1,52 @@
+package starrocks
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "3.3.5-6d81f75", want: "3.3.5"},
+		{input: "4.1.1-ee-abc", want: "4.1.1"},
+		{input: "v2.5", want: "2.5.0"},
+		{input: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		v, err := ParseVersion(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseVersion(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && v.String() != tt.want {
+			t.Errorf("ParseVersion(%q) = %s, want %s", tt.input, v, tt.want)
+		}
+		if v.Raw != tt.input {
+			t.Errorf("ParseVersion(%q).Raw = %q", tt.input, v.Raw)
+		}
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	v := Version{Major: 3, Minor: 3, Patch: 5}
+
+	tests := []struct {
+		major, minor, patch int
+		want                bool
+	}{
+		{3, 3, 5, true},
+		{3, 3, 6, false},
+		{3, 2, 9, true},
+		{3, 4, 0, false},
+		{2, 9, 9, true},
+		{4, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := v.AtLeast(tt.major, tt.minor, tt.patch); got != tt.want {
+			t.Errorf("%s.AtLeast(%d, %d, %d) = %v, want %v", v, tt.major, tt.minor, tt.patch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &viewResource{}
+	_ resource.ResourceWithConfigure   = &viewResource{}
+	_ resource.ResourceWithImportState = &viewResource{}
+)
+
+func NewViewResource() resource.Resource {
+	return &viewResource{}
+}
+
+type viewResource struct {
+	client *Client
+}
+
+type viewResourceModel struct {
+	Database types.String  `tfsdk:"database"`
+	Name     types.String  `tfsdk:"name"`
+	Query    SQLQueryValue `tfsdk:"query"`
+	Comment  types.String  `tfsdk:"comment"`
+	Columns  types.List    `tfsdk:"columns"`
+}
+
+func (m *viewResourceModel) view() *View {
+	return &View{
+		Database: m.Database,
+		Name:     m.Name,
+		Query:    m.Query.StringValue,
+		Comment:  m.Comment,
+		Columns:  viewColumnsFromList(m.Columns),
+	}
+}
+
+func (r *viewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view"
+}
+
+func (r *viewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks logical view.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				CustomType: SQLQueryType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"columns": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"comment": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *viewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan viewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateView(plan.view(), false); err != nil {
+		resp.Diagnostics.AddError("Unable to Create View", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *viewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state viewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.client.GetView(state.Database.ValueString(), state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading view", err.Error())
+		return
+	}
+
+	// The FE stores the analyzed query with quoted, qualified identifiers, so
+	// the configured text is kept while it is still the same query.
+	if !sameViewQuery(state.Database.ValueString(), state.Query.ValueString(), view.Query.ValueString()) {
+		state.Query = SQLQueryValue{StringValue: view.Query}
+	}
+	state.Comment = view.Comment
+	if !state.Columns.IsNull() {
+		state.Columns = viewColumnsToList(view.Columns)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *viewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state viewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ALTER VIEW cannot change the view comment, so a comment change
+	// replaces the whole definition.
+	var err error
+	if !plan.Comment.Equal(state.Comment) {
+		err = r.client.CreateView(plan.view(), true)
+	} else {
+		err = r.client.AlterView(plan.view())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Update View", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *viewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state viewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropView(state.Database.ValueString(), state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop View", err.Error())
+	}
+}
+
+func (r *viewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	db, name, ok := strings.Cut(req.ID, ".")
+	if !ok || db == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <database>.<view>, got: %q", req.ID))
+		return
+	}
+
+	view, err := r.client.GetView(db, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing view", err.Error())
+		return
+	}
+
+	state := viewResourceModel{
+		Database: view.Database,
+		Name:     view.Name,
+		Query:    SQLQueryValue{StringValue: view.Query},
+		Comment:  view.Comment,
+		Columns:  types.ListNull(types.ObjectType{AttrTypes: viewColumnAttrTypes}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *viewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}
@@ -0,0 +1,297 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &warehouseResource{}
+	_ resource.ResourceWithConfigure      = &warehouseResource{}
+	_ resource.ResourceWithImportState    = &warehouseResource{}
+	_ resource.ResourceWithValidateConfig = &warehouseResource{}
+	_ resource.ResourceWithModifyPlan     = &warehouseResource{}
+)
+
+func NewWarehouseResource() resource.Resource {
+	return &warehouseResource{}
+}
+
+type warehouseResource struct {
+	client *Client
+}
+
+type warehouseResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	Comment        types.String `tfsdk:"comment"`
+	ComputeReplica types.Int64  `tfsdk:"compute_replica"`
+	Suspended      types.Bool   `tfsdk:"suspended"`
+	Properties     types.Map    `tfsdk:"properties"`
+	NodeCount      types.Int64  `tfsdk:"node_count"`
+}
+
+// warehouseProperties renders compute_replica and extra properties as
+// warehouse properties.
+func (m *warehouseResourceModel) warehouseProperties() map[string]string {
+	props := mapValues(m.Properties)
+	if props == nil {
+		props = make(map[string]string)
+	}
+	if v := m.ComputeReplica; !v.IsNull() && !v.IsUnknown() {
+		props[warehouseComputeReplica] = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	return props
+}
+
+// applyComputed sets the computed attributes of a planned model after the
+// warehouse was created or altered.
+func (m *warehouseResourceModel) applyComputed(wh *Warehouse) {
+	m.NodeCount = wh.NodeCount
+	if m.ComputeReplica.IsUnknown() {
+		m.ComputeReplica = parseInt64Column(wh.Properties[warehouseComputeReplica], false)
+	}
+}
+
+// refresh updates the model from the server.
+func (m *warehouseResourceModel) refresh(wh *Warehouse) diag.Diagnostics {
+	m.Comment = wh.Comment
+	m.Suspended = wh.Suspended
+	m.NodeCount = wh.NodeCount
+
+	props := make(map[string]string, len(wh.Properties))
+	for k, v := range wh.Properties {
+		props[k] = v
+	}
+	m.ComputeReplica = parseInt64Column(props[warehouseComputeReplica], false)
+	delete(props, warehouseComputeReplica)
+
+	var diags diag.Diagnostics
+	m.Properties, diags = trackedProperties(m.Properties, stringMap(props))
+	return diags
+}
+
+func (r *warehouseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse"
+}
+
+func (r *warehouseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a StarRocks warehouse on a shared-data cluster.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compute_replica": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"suspended": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"properties": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					untrackedPropertiesWarning{},
+				},
+			},
+			"node_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *warehouseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config warehouseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := mapValues(config.Properties)[warehouseComputeReplica]; ok {
+		resp.Diagnostics.AddAttributeError(path.Root("properties"), "Conflicting Property", `Use the compute_replica attribute instead of the "compute_replica" property.`)
+	}
+}
+
+// ModifyPlan marks node_count unknown when scaling, suspending or resuming
+// the warehouse, since it is only known after the change.
+func (r *warehouseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state warehouseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ComputeReplica.Equal(state.ComputeReplica) || !plan.Suspended.Equal(state.Suspended) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("node_count"), types.Int64Unknown())...)
+	}
+}
+
+func (r *warehouseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan warehouseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	if err := r.client.CreateWarehouse(name, plan.Comment, plan.warehouseProperties()); err != nil {
+		resp.Diagnostics.AddError("Unable to Create Warehouse", err.Error())
+		return
+	}
+
+	if plan.Suspended.ValueBool() {
+		if err := r.client.SetWarehouseSuspended(name, true); err != nil {
+			resp.Diagnostics.AddError("Unable to Suspend Warehouse", err.Error())
+			return
+		}
+	}
+
+	wh, err := r.client.GetWarehouse(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading warehouse", err.Error())
+		return
+	}
+	plan.applyComputed(wh)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *warehouseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state warehouseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wh, err := r.client.GetWarehouse(state.Name.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading warehouse", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(wh)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *warehouseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state warehouseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	changed := changedProperties(state.warehouseProperties(), plan.warehouseProperties())
+	if err := r.client.SetWarehouseProperties(name, changed); err != nil {
+		resp.Diagnostics.AddError("Unable to Alter Warehouse", err.Error())
+		return
+	}
+
+	if !plan.Suspended.Equal(state.Suspended) {
+		if err := r.client.SetWarehouseSuspended(name, plan.Suspended.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Unable to Suspend or Resume Warehouse", err.Error())
+			return
+		}
+	}
+
+	wh, err := r.client.GetWarehouse(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading warehouse", err.Error())
+		return
+	}
+	plan.applyComputed(wh)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *warehouseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state warehouseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DropWarehouse(state.Name.ValueString()); err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to Drop Warehouse", err.Error())
+	}
+}
+
+func (r *warehouseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	wh, err := r.client.GetWarehouse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing warehouse", err.Error())
+		return
+	}
+
+	state := warehouseResourceModel{
+		Name:       wh.Name,
+		Properties: types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(state.refresh(wh)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *warehouseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *Client, got: %T", req.ProviderData))
+		return
+	}
+
+	r.client = c
+}